@@ -12,4 +12,22 @@ resource "endpointmonitor_maintenance_period" "example" {
   start_time  = "01:00"
   end_time    = "03:00"
   check_ids   = [data.endpointmonitor_check.example.id]
-}
\ No newline at end of file
+}
+
+# Example use of endpointmonitor_check data source to reuse the
+# configuration of an existing check by its exact name.
+
+data "endpointmonitor_check" "homepage" {
+  name = "Website Homepage"
+}
+
+resource "endpointmonitor_certificate_check" "homepage" {
+  name                   = "Website Homepage Certificate"
+  check_frequency        = data.endpointmonitor_check.homepage.check_frequency
+  url                    = data.endpointmonitor_check.homepage.url_check.url
+  warning_days_remaining = 14
+  alert_days_remaining   = 3
+  trigger_count          = data.endpointmonitor_check.homepage.trigger_count
+  check_group_id         = data.endpointmonitor_check.homepage.check_group_id
+  check_host_id          = data.endpointmonitor_check.homepage.check_host_id
+}
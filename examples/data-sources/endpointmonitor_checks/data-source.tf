@@ -2,7 +2,9 @@
 # to add to a maintenance period.
 
 data "endpointmonitor_checks" "websites" {
-  search = "Website"
+  search     = "Website"
+  check_type = "URL"
+  enabled    = true
 }
 
 resource "endpointmonitor_maintenance_period" "example" {
@@ -12,4 +14,19 @@ resource "endpointmonitor_maintenance_period" "example" {
   start_time  = "01:00"
   end_time    = "03:00"
   check_ids   = data.endpointmonitor_checks.websites.ids
-}
\ No newline at end of file
+}
+
+# Filters can be used without a search, to find every check matching them,
+# and the summary of each check can be used as well as its id.
+
+data "endpointmonitor_checks" "frequent" {
+  name_regex     = "^(Prod|Live) "
+  check_group_id = 12
+}
+
+output "frequent_checks" {
+  value = {
+    for check in data.endpointmonitor_checks.frequent.checks : check.name => check.check_frequency
+    if check.check_frequency < 60
+  }
+}
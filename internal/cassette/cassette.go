@@ -0,0 +1,184 @@
+// Package cassette records the provider's traffic with the EndPoint Monitor API to a
+// file, and replays it later in place of the API, so a problem seen against a real
+// installation can be reproduced without it.
+//
+// Recordings are sanitized as they are made. Only the path and query of each request
+// are kept, no request headers are kept, and the values of any JSON fields that are
+// secret by the rules of package redact are replaced, as are the values of request
+// headers sent by URL checks, so a cassette can be attached to a bug report.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Redacted replaces the values of secrets in recorded bodies.
+const Redacted = "REDACTED"
+
+// Cassette is a recording of the requests made to the API and the responses to them,
+// in the order they were made.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request and the response it was given.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. The URL is only its path and query, so a cassette
+// can be replayed whatever host it was recorded against.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body
+}
+
+// Response is a recorded response. Only the headers the provider reads are kept.
+type Response struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body
+}
+
+// Body is a recorded request or response body. JSON bodies are kept as JSON, so they
+// can be read and edited in the cassette, and anything else is kept as text.
+type Body struct {
+	JSON json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+func (b Body) bytes() []byte {
+	if b.Text != "" {
+		return []byte(b.Text)
+	}
+
+	return b.JSON
+}
+
+// Load reads a cassette from a file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("unable to parse cassette %s: %w", path, err)
+	}
+
+	return &c, nil
+}
+
+// Save writes the cassette to a file, replacing anything already there.
+func (c *Cassette) Save(path string) error {
+	data, err := marshal(c, "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+// lockTimeout is how long to wait for another process adding to the same cassette,
+// and lockPoll how often to check whether it has finished.
+const (
+	lockTimeout = 30 * time.Second
+	lockPoll    = 50 * time.Millisecond
+)
+
+// appendInteractions adds interactions to the end of the cassette at path, creating
+// it if it doesn't exist. The file is locked while it's changed, as each provider
+// process records its own traffic, and Terraform runs several, such as one for each
+// provider configuration and for both plan and apply.
+func appendInteractions(path string, interactions []Interaction) error {
+	unlock, err := lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	c, err := Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		c, err = &Cassette{Interactions: []Interaction{}}, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	c.Interactions = append(c.Interactions, interactions...)
+
+	data, err := marshal(c, "  ")
+	if err != nil {
+		return err
+	}
+
+	// The cassette is replaced in one step, so a process that stops part way through
+	// can't leave it half written.
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return err
+	}
+
+	if err := temp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(temp.Name(), path)
+}
+
+// lock takes the lock on the cassette at path, which is a file alongside it, waiting
+// for any other process holding it. It returns a function that releases it.
+func lock(path string) (func(), error) {
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for %s, which can be removed if no provider is still recording", lockPath)
+		}
+
+		time.Sleep(lockPoll)
+	}
+}
+
+// marshal encodes a value as JSON without escaping HTML characters, which would only
+// make URLs and bodies in a cassette harder to read.
+func marshal(value any, indent string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", indent)
+
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,43 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"terraform-provider-endpointmonitor/internal/redact"
+)
+
+// unkeptFields are redacted along with secrets. The contents of APKs aren't secret as
+// such, but are large and belong to whoever wrote the app, so they aren't kept.
+var unkeptFields = []string{"apk"}
+
+// recordedHeaders are the only response headers kept, as the provider reads no others.
+var recordedHeaders = []string{"Content-Type", "Retry-After"}
+
+// sanitizeBody returns a body to record, with any secrets in it redacted. Bodies that
+// aren't JSON can't be searched for secrets, so aren't kept unless they are responses,
+// such as error pages from a proxy, which the provider may show in an error.
+func sanitizeBody(data []byte, response bool) Body {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return Body{}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		if response {
+			return Body{Text: string(data)}
+		}
+
+		return Body{Text: Redacted}
+	}
+
+	sanitized, err := marshal(redact.JSON(value, Redacted, unkeptFields...), "")
+	if err != nil {
+		return Body{Text: Redacted}
+	}
+
+	return Body{JSON: bytes.TrimSpace(sanitized)}
+}
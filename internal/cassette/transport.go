@@ -0,0 +1,181 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Recorder is an http.RoundTripper that sends requests on to another and records each
+// of them, with its response, to a cassette file. The file is written when the
+// Recorder is closed, so nothing is recorded if the process using it ends first.
+type Recorder struct {
+	next     http.RoundTripper
+	path     string
+	mu       sync.Mutex
+	cassette Cassette
+	// saved is the number of interactions already added to the file.
+	saved int
+}
+
+// NewRecorder returns a Recorder that sends requests with next and records them to the
+// file at path. They are added after anything already there when it is closed, so
+// the traffic of each process recording to the same file is kept.
+func NewRecorder(path string, next http.RoundTripper) *Recorder {
+	return &Recorder{next: next, path: path}
+}
+
+// RoundTrip sends the request and records it. Requests that fail without a response,
+// such as when the connection is dropped, aren't recorded.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+
+	if req.Body != nil {
+		var err error
+		if requestBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	res, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Body:   sanitizeBody(requestBody, false),
+		},
+		Response: Response{
+			StatusCode: res.StatusCode,
+			Body:       sanitizeBody(responseBody, true),
+		},
+	}
+
+	for _, name := range recordedHeaders {
+		if value := res.Header.Get(name); value != "" {
+			if interaction.Response.Headers == nil {
+				interaction.Response.Headers = map[string]string{}
+			}
+
+			interaction.Response.Headers[name] = value
+		}
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return res, nil
+}
+
+// Close adds the requests recorded since it was last closed to the cassette file.
+// Requests made after it is closed are still recorded, and added if it is closed
+// again.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := appendInteractions(r.path, r.cassette.Interactions[r.saved:]); err != nil {
+		return fmt.Errorf("unable to save cassette %s: %w", r.path, err)
+	}
+
+	r.saved = len(r.cassette.Interactions)
+
+	return nil
+}
+
+// Replayer is an http.RoundTripper that answers requests from a cassette rather than
+// sending them. Each request is given the next response recorded for the same method
+// and URL, so requests for different items can be made in any order, as Terraform
+// does, while repeated requests for the same item get their responses in the order
+// they were recorded.
+type Replayer struct {
+	mu      sync.Mutex
+	pending map[string][]Interaction
+}
+
+// NewReplayer returns a Replayer that answers requests from the given cassette.
+func NewReplayer(c *Cassette) *Replayer {
+	pending := map[string][]Interaction{}
+
+	for _, interaction := range c.Interactions {
+		key := replayKey(interaction.Request.Method, interaction.Request.URL)
+		pending[key] = append(pending[key], interaction)
+	}
+
+	return &Replayer{pending: pending}
+}
+
+// RoundTrip answers the request with the next recorded response for it, or fails if
+// every response recorded for it has been used.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	key := replayKey(req.Method, req.URL.RequestURI())
+
+	r.mu.Lock()
+	interactions := r.pending[key]
+	if len(interactions) == 0 {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("cassette has no recorded response left for %s", key)
+	}
+	r.pending[key] = interactions[1:]
+	r.mu.Unlock()
+
+	recorded := interactions[0].Response
+
+	res := &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          io.NopCloser(bytes.NewReader(recorded.bytes())),
+		ContentLength: int64(len(recorded.bytes())),
+		Request:       req,
+	}
+
+	for name, value := range recorded.Headers {
+		res.Header.Set(name, value)
+	}
+
+	return res, nil
+}
+
+// Remaining returns the number of recorded responses that haven't been used, so a
+// test can check every request it expected was made.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	remaining := 0
+	for _, interactions := range r.pending {
+		remaining += len(interactions)
+	}
+
+	return remaining
+}
+
+func replayKey(method string, url string) string {
+	return method + " " + url
+}
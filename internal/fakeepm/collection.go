@@ -0,0 +1,142 @@
+package fakeepm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// collection stores the items of one type, keyed by id. The server's lock must be
+// held to use it.
+type collection struct {
+	items  map[int64]map[string]any
+	order  []int64
+	nextID int64
+}
+
+func newCollection() *collection {
+	return &collection{
+		items:  map[int64]map[string]any{},
+		nextID: 1,
+	}
+}
+
+// add stores a new item, giving it the next id.
+func (c *collection) add(item map[string]any) int64 {
+	id := c.nextID
+	c.nextID++
+
+	item["id"] = json.Number(strconv.FormatInt(id, 10))
+	c.items[id] = item
+	c.order = append(c.order, id)
+
+	return id
+}
+
+// update replaces the item with the same id, returning false if there isn't one.
+func (c *collection) update(item map[string]any) bool {
+	id, ok := itemID(item)
+	if !ok {
+		return false
+	}
+
+	if _, found := c.items[id]; !found {
+		return false
+	}
+
+	c.items[id] = item
+
+	return true
+}
+
+func (c *collection) get(id int64) (map[string]any, bool) {
+	item, found := c.items[id]
+
+	return item, found
+}
+
+func (c *collection) remove(id int64) bool {
+	if _, found := c.items[id]; !found {
+		return false
+	}
+
+	delete(c.items, id)
+
+	for i, orderID := range c.order {
+		if orderID == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+
+	return true
+}
+
+// list returns a page of the items whose name, hostname or description contains the
+// search string, ignoring case. A page size of zero returns every item.
+func (c *collection) list(search string, page int, pageSize int) []map[string]any {
+	matches := []map[string]any{}
+
+	for _, id := range c.order {
+		if item := c.items[id]; matchesSearch(item, search) {
+			matches = append(matches, item)
+		}
+	}
+
+	if pageSize <= 0 {
+		return matches
+	}
+
+	start := page * pageSize
+	if page < 0 || start >= len(matches) {
+		return []map[string]any{}
+	}
+
+	return matches[start:min(start+pageSize, len(matches))]
+}
+
+func matchesSearch(item map[string]any, search string) bool {
+	if search == "" {
+		return true
+	}
+
+	for _, field := range []string{"name", "hostname", "description"} {
+		if value, ok := item[field].(string); ok && strings.Contains(strings.ToLower(value), strings.ToLower(search)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func itemID(item map[string]any) (int64, bool) {
+	id, err := strconv.ParseInt(fmt.Sprint(item["id"]), 10, 64)
+
+	return id, err == nil
+}
+
+// copyItem returns a deep copy of an item, so it can be used without holding the
+// server's lock.
+func copyItem(item map[string]any) map[string]any {
+	return copyValue(item).(map[string]any)
+}
+
+func copyValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(value))
+		for key, v := range value {
+			copied[key] = copyValue(v)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(value))
+		for i, v := range value {
+			copied[i] = copyValue(v)
+		}
+		return copied
+	default:
+		return value
+	}
+}
@@ -0,0 +1,320 @@
+// Package fakeepm is an in-memory fake of the EndPoint Monitor API, served with
+// httptest, so the provider can be exercised without a real EPM installation.
+//
+// Items are stored as the JSON objects sent to the fake and returned as they were
+// sent, with only their ids assigned by the fake. It doesn't validate items as EPM
+// does, so it shows what the provider sends rather than whether EPM would accept it.
+package fakeepm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The collections of items the fake serves, named after their paths in the API. Note
+// that EPM serves proxy hosts under /proxies.
+const (
+	Checks                    = "checks"
+	CheckGroups               = "checkGroups"
+	CheckHosts                = "hosts"
+	HostGroups                = "hostGroups"
+	ProxyHosts                = "proxies"
+	DashboardGroups           = "dashboardGroups"
+	MaintenancePeriods        = "maintenancePeriods"
+	AndroidJourneyCommonSteps = "checks/commonSteps/android"
+	WebJourneyCommonSteps     = "checks/commonSteps/web"
+)
+
+// DefaultPageSize is the number of items returned for each page of a list.
+const DefaultPageSize = 20
+
+// Server is a fake EPM API. Its API is served under /api, as it is by EPM, so
+// APIURL rather than URL should be given to the provider.
+type Server struct {
+	*httptest.Server
+
+	// Key is the API key requests must give, in either the x-epm-auth header or as a
+	// bearer token. Any request is accepted if it is empty.
+	Key string
+	// PageSize is the number of items returned for each page of a list.
+	PageSize int
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	info        any
+	failures    []failure
+	requests    []string
+}
+
+// New starts a fake EPM API that requires the given API key. It should be closed
+// once finished with.
+func New(key string) *Server {
+	s := &Server{
+		Key:         key,
+		PageSize:    DefaultPageSize,
+		collections: map[string]*collection{},
+	}
+
+	for _, name := range []string{Checks, CheckGroups, CheckHosts, HostGroups, ProxyHosts, DashboardGroups,
+		MaintenancePeriods, AndroidJourneyCommonSteps, WebJourneyCommonSteps} {
+		s.collections[name] = newCollection()
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// APIURL returns the base path of the fake's API, to be used as the provider's url.
+func (s *Server) APIURL() string {
+	return s.URL + "/api"
+}
+
+// SetInfo sets what the fake reports about itself from /info. Until it is set, /info
+// isn't found, as with versions of EPM that don't report anything about themselves.
+func (s *Server) SetInfo(version string, capabilities []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.info = map[string]any{"version": version, "capabilities": capabilities}
+}
+
+// failure is a response the fake gives in place of handling a request. A nil body is
+// replaced by an error in the same shape as EPM's own.
+type failure struct {
+	status int
+	body   any
+}
+
+// Fail makes the next requests fail with the given status codes, one for each request
+// in turn, such as to exercise retries.
+func (s *Server) Fail(statusCodes ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, status := range statusCodes {
+		s.failures = append(s.failures, failure{status: status})
+	}
+}
+
+// Reject makes the next request, after any failures already queued, fail with the
+// given status code and JSON body, such as the field errors EPM returns for an item
+// it won't accept.
+func (s *Server) Reject(status int, body any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, failure{status: status, body: body})
+}
+
+// Requests returns the method and path of each request made to the fake so far, such
+// as "GET /api/checks/1".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+// Add stores a copy of an item in a collection, as if it had been created through
+// the API, and returns its new id.
+func (s *Server) Add(collection string, item map[string]any) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.collections[collection].add(copyItem(item))
+}
+
+// Get returns a copy of an item from a collection, or false if there is no item with
+// that id.
+func (s *Server) Get(collection string, id int64) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, found := s.collections[collection].get(id)
+	if !found {
+		return nil, false
+	}
+
+	return copyItem(item), true
+}
+
+// Items returns a copy of every item in a collection, in the order they were added.
+func (s *Server) Items(collection string) []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := []map[string]any{}
+	for _, item := range s.collections[collection].list("", 0, 0) {
+		items = append(items, copyItem(item))
+	}
+
+	return items
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+	if len(s.failures) > 0 {
+		failure := s.failures[0]
+		s.failures = s.failures[1:]
+
+		if failure.body != nil {
+			writeJSON(w, failure.status, failure.body)
+			return
+		}
+
+		writeError(w, failure.status, http.StatusText(failure.status))
+		return
+	}
+
+	if !s.authenticated(r) {
+		writeError(w, http.StatusUnauthorized, "Invalid API key")
+		return
+	}
+
+	path, found := strings.CutPrefix(r.URL.Path, "/api/")
+	if !found {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	if path == "info" && r.Method == http.MethodGet {
+		if s.info == nil {
+			writeError(w, http.StatusNotFound, "Not found")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, s.info)
+		return
+	}
+
+	name, rest := s.route(path)
+	if name == "" {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	s.serveCollection(w, r, s.collections[name], rest)
+}
+
+func (s *Server) authenticated(r *http.Request) bool {
+	if s.Key == "" {
+		return true
+	}
+
+	return r.Header.Get("x-epm-auth") == s.Key || r.Header.Get("Authorization") == "Bearer "+s.Key
+}
+
+// route finds the collection a path belongs to, preferring the longest match so the
+// common steps aren't mistaken for checks, and returns the rest of the path.
+func (s *Server) route(path string) (string, string) {
+	match := ""
+
+	for name := range s.collections {
+		if (path == name || strings.HasPrefix(path, name+"/")) && len(name) > len(match) {
+			match = name
+		}
+	}
+
+	return match, strings.TrimPrefix(strings.TrimPrefix(path, match), "/")
+}
+
+// serveCollection handles the requests EPM accepts for each type of item:
+//
+//	GET    {collection}/list?page=0&search=
+//	GET    {collection}/{id}
+//	PUT    {collection}/add[/{type}]
+//	POST   {collection}/update[/{type}]
+//	DELETE {collection}/remove/{id}
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, c *collection, rest string) {
+	action, arg, _ := strings.Cut(rest, "/")
+
+	switch {
+	case r.Method == http.MethodGet && action == "list" && arg == "":
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		writeJSON(w, http.StatusOK, c.list(r.URL.Query().Get("search"), page, s.PageSize))
+	case r.Method == http.MethodPut && action == "add":
+		item, ok := readItem(w, r)
+		if !ok {
+			return
+		}
+
+		c.add(item)
+
+		writeJSON(w, http.StatusOK, item)
+	case r.Method == http.MethodPost && action == "update":
+		item, ok := readItem(w, r)
+		if !ok {
+			return
+		}
+
+		if !c.update(item) {
+			writeError(w, http.StatusNotFound, "Not found")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, item)
+	case r.Method == http.MethodDelete && action == "remove":
+		id, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || !c.remove(id) {
+			writeError(w, http.StatusNotFound, "Not found")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+	case r.Method == http.MethodGet && arg == "":
+		id, err := strconv.ParseInt(action, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusNotFound, "Not found")
+			return
+		}
+
+		item, found := c.get(id)
+		if !found {
+			writeError(w, http.StatusNotFound, "Not found")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, item)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func readItem(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	item := map[string]any{}
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&item); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return nil, false
+	}
+
+	return item, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError responds with an error in the same shape as EPM's own.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{
+		"error":   http.StatusText(status),
+		"message": message,
+	})
+}
@@ -0,0 +1,269 @@
+package fakeepm_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+const testKey = "test-key"
+
+// do makes a request to the fake and returns the status code and decoded body.
+func do(t *testing.T, server *fakeepm.Server, method string, path string, body string, key string) (int, any) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, server.APIURL()+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key != "" {
+		req.Header.Set("x-epm-auth", key)
+	}
+
+	res, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("response to %s %s isn't JSON: %s", method, path, raw)
+	}
+
+	return res.StatusCode, decoded
+}
+
+func TestServerCollection(t *testing.T) {
+	server := fakeepm.New(testKey)
+	defer server.Close()
+
+	status, body := do(t, server, http.MethodPut, "/checkGroups/add", `{"name":"Web","description":"Web checks"}`, testKey)
+	if status != http.StatusOK {
+		t.Fatalf("add: got status %d, want 200: %v", status, body)
+	}
+	if id := body.(map[string]any)["id"]; id != float64(1) {
+		t.Fatalf("add: got id %v, want 1", id)
+	}
+
+	status, body = do(t, server, http.MethodGet, "/checkGroups/1", "", testKey)
+	if status != http.StatusOK || body.(map[string]any)["name"] != "Web" {
+		t.Fatalf("get: got status %d and %v", status, body)
+	}
+
+	status, body = do(t, server, http.MethodPost, "/checkGroups/update", `{"id":1,"name":"Web servers"}`, testKey)
+	if status != http.StatusOK {
+		t.Fatalf("update: got status %d, want 200: %v", status, body)
+	}
+
+	item, found := server.Get(fakeepm.CheckGroups, 1)
+	if !found || item["name"] != "Web servers" {
+		t.Fatalf("update: got stored item %v", item)
+	}
+
+	status, _ = do(t, server, http.MethodPost, "/checkGroups/update", `{"id":2,"name":"Missing"}`, testKey)
+	if status != http.StatusNotFound {
+		t.Fatalf("update of missing item: got status %d, want 404", status)
+	}
+
+	status, _ = do(t, server, http.MethodDelete, "/checkGroups/remove/1", "", testKey)
+	if status != http.StatusOK {
+		t.Fatalf("remove: got status %d, want 200", status)
+	}
+
+	status, _ = do(t, server, http.MethodGet, "/checkGroups/1", "", testKey)
+	if status != http.StatusNotFound {
+		t.Fatalf("get after remove: got status %d, want 404", status)
+	}
+
+	status, _ = do(t, server, http.MethodDelete, "/checkGroups/remove/1", "", testKey)
+	if status != http.StatusNotFound {
+		t.Fatalf("remove of missing item: got status %d, want 404", status)
+	}
+
+	status, _ = do(t, server, http.MethodPut, "/checkGroups/add", `not json`, testKey)
+	if status != http.StatusBadRequest {
+		t.Fatalf("add with invalid body: got status %d, want 400", status)
+	}
+}
+
+func TestServerList(t *testing.T) {
+	server := fakeepm.New(testKey)
+	defer server.Close()
+
+	server.PageSize = 2
+
+	for _, name := range []string{"Alpha", "Beta", "Gamma", "alphabet"} {
+		server.Add(fakeepm.HostGroups, map[string]any{"name": name})
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "?page=0", want: []string{"Alpha", "Beta"}},
+		{query: "?page=1", want: []string{"Gamma", "alphabet"}},
+		{query: "?page=2", want: []string{}},
+		{query: "?page=0&search=ALPHA", want: []string{"Alpha", "alphabet"}},
+		{query: "?page=0&search=delta", want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			status, body := do(t, server, http.MethodGet, "/hostGroups/list"+test.query, "", testKey)
+			if status != http.StatusOK {
+				t.Fatalf("got status %d, want 200", status)
+			}
+
+			names := []string{}
+			for _, item := range body.([]any) {
+				names = append(names, item.(map[string]any)["name"].(string))
+			}
+
+			if strings.Join(names, ",") != strings.Join(test.want, ",") {
+				t.Errorf("got %v, want %v", names, test.want)
+			}
+		})
+	}
+}
+
+func TestServerRoutesCommonStepsApartFromChecks(t *testing.T) {
+	server := fakeepm.New(testKey)
+	defer server.Close()
+
+	status, _ := do(t, server, http.MethodPut, "/checks/commonSteps/web/add", `{"name":"Log in"}`, testKey)
+	if status != http.StatusOK {
+		t.Fatalf("add: got status %d, want 200", status)
+	}
+
+	if len(server.Items(fakeepm.WebJourneyCommonSteps)) != 1 || len(server.Items(fakeepm.Checks)) != 0 {
+		t.Fatalf("common step stored in the wrong collection")
+	}
+
+	status, _ = do(t, server, http.MethodPut, "/checks/add/url", `{"name":"Home page"}`, testKey)
+	if status != http.StatusOK || len(server.Items(fakeepm.Checks)) != 1 {
+		t.Fatalf("check with type not stored as a check: got status %d", status)
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	server := fakeepm.New(testKey)
+	defer server.Close()
+
+	if status, _ := do(t, server, http.MethodGet, "/checks/list", "", ""); status != http.StatusUnauthorized {
+		t.Errorf("without key: got status %d, want 401", status)
+	}
+
+	if status, _ := do(t, server, http.MethodGet, "/checks/list", "", "wrong"); status != http.StatusUnauthorized {
+		t.Errorf("with wrong key: got status %d, want 401", status)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.APIURL()+"/checks/list", nil)
+	req.Header.Set("Authorization", "Bearer "+testKey)
+
+	res, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("with bearer token: got status %d, want 200", res.StatusCode)
+	}
+}
+
+func TestServerInfo(t *testing.T) {
+	server := fakeepm.New("")
+	defer server.Close()
+
+	if status, _ := do(t, server, http.MethodGet, "/info", "", ""); status != http.StatusNotFound {
+		t.Errorf("before SetInfo: got status %d, want 404", status)
+	}
+
+	server.SetInfo("5.2.0", []string{"webJourneyAction:CLICK"})
+
+	status, body := do(t, server, http.MethodGet, "/info", "", "")
+	if status != http.StatusOK || body.(map[string]any)["version"] != "5.2.0" {
+		t.Errorf("after SetInfo: got status %d and %v", status, body)
+	}
+}
+
+func TestServerFail(t *testing.T) {
+	server := fakeepm.New(testKey)
+	defer server.Close()
+
+	server.Fail(http.StatusServiceUnavailable, http.StatusBadGateway)
+
+	for _, want := range []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK} {
+		if status, _ := do(t, server, http.MethodGet, "/checks/list", "", testKey); status != want {
+			t.Errorf("got status %d, want %d", status, want)
+		}
+	}
+
+	requests := server.Requests()
+	if len(requests) != 3 || requests[0] != "GET /api/checks/list" {
+		t.Errorf("got requests %v", requests)
+	}
+}
+
+func TestServerReject(t *testing.T) {
+	server := fakeepm.New(testKey)
+	defer server.Close()
+
+	server.Reject(http.StatusBadRequest, map[string]any{"errors": []any{map[string]any{"field": "name", "message": "must not be blank"}}})
+
+	status, body := do(t, server, http.MethodPut, "/checkGroups/add", `{"name": ""}`, testKey)
+	if status != http.StatusBadRequest || !strings.Contains(fmt.Sprint(body), "must not be blank") {
+		t.Errorf("got status %d and body %v", status, body)
+	}
+
+	if items := server.Items(fakeepm.CheckGroups); len(items) != 0 {
+		t.Errorf("rejected item was added: %v", items)
+	}
+}
+
+func TestServerReturnsCopies(t *testing.T) {
+	server := fakeepm.New(testKey)
+	defer server.Close()
+
+	added := map[string]any{"name": "Web", "tags": []any{"a"}}
+	id := server.Add(fakeepm.CheckGroups, added)
+
+	added["name"] = "Changed after add"
+
+	item, _ := server.Get(fakeepm.CheckGroups, id)
+	if item["name"] != "Web" {
+		t.Fatalf("item changed through the map given to Add: %v", item)
+	}
+
+	item["name"] = "Changed after get"
+	item["tags"].([]any)[0] = "b"
+
+	for _, item := range server.Items(fakeepm.CheckGroups) {
+		if item["name"] != "Web" || item["tags"].([]any)[0] != "a" {
+			t.Fatalf("item changed through the map returned by Get: %v", item)
+		}
+
+		item["name"] = "Changed after items"
+	}
+
+	item, _ = server.Get(fakeepm.CheckGroups, id)
+	if item["name"] != "Web" {
+		t.Fatalf("item changed through the map returned by Items: %v", item)
+	}
+
+	if _, found := server.Get(fakeepm.CheckGroups, id+1); found {
+		t.Fatalf("found an item that was never added")
+	}
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// credentialExpiryMargin is how long before a credential's expiry it is replaced, so
+// it can't expire part way through a request.
+const credentialExpiryMargin = 30 * time.Second
+
+// OAuth2Config holds the settings used to fetch bearer tokens with the OAuth2 client
+// credentials flow.
+type OAuth2Config struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+// authenticator adds the credentials the EPM API expects to each request.
+type authenticator interface {
+	authenticate(req *http.Request) error
+}
+
+// newAuthenticator creates the authenticator for the one authentication method set in
+// the client config.
+func newAuthenticator(config EndPointMonitorClientConfig, httpClient *http.Client) (authenticator, error) {
+	switch {
+	case config.OAuth2 != nil:
+		if config.OAuth2.TokenURL == "" || config.OAuth2.ClientID == "" || config.OAuth2.ClientSecret == "" {
+			return nil, errors.New("a token URL, client id and client secret must all be given to use OAuth2")
+		}
+
+		return &oauth2Authenticator{
+			config: clientcredentials.Config{
+				TokenURL:     config.OAuth2.TokenURL,
+				ClientID:     config.OAuth2.ClientID,
+				ClientSecret: config.OAuth2.ClientSecret,
+				Scopes:       config.OAuth2.Scopes,
+			},
+			httpClient: httpClient,
+		}, nil
+	case len(config.CredentialHelper) > 0:
+		return &credentialHelperAuthenticator{command: config.CredentialHelper}, nil
+	default:
+		return apiKeyAuthenticator{key: config.ApiKey}, nil
+	}
+}
+
+// apiKeyAuthenticator sends a static API key in the x-epm-auth header.
+type apiKeyAuthenticator struct {
+	key string
+}
+
+func (a apiKeyAuthenticator) authenticate(req *http.Request) error {
+	req.Header.Set("x-epm-auth", a.key)
+	return nil
+}
+
+// oauth2Authenticator sends a bearer token from the OAuth2 client credentials flow.
+// Tokens are reused until they expire, and are fetched with the context of the request
+// that needs one, so cancelling the request stops waiting on the token endpoint too.
+type oauth2Authenticator struct {
+	config     clientcredentials.Config
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+func (a *oauth2Authenticator) authenticate(req *http.Request) error {
+	token, err := a.get(req.Context())
+	if err != nil {
+		return fmt.Errorf("unable to fetch OAuth2 token: %w", err)
+	}
+
+	token.SetAuthHeader(req)
+	return nil
+}
+
+func (a *oauth2Authenticator) get(ctx context.Context) (*oauth2.Token, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != nil && (a.token.Expiry.IsZero() || time.Until(a.token.Expiry) > credentialExpiryMargin) {
+		return a.token, nil
+	}
+
+	// Tokens are fetched with the same TLS and proxy settings as the EPM API.
+	token, err := a.config.Token(context.WithValue(ctx, oauth2.HTTPClient, a.httpClient))
+	if err != nil {
+		return nil, err
+	}
+
+	a.token = token
+
+	return token, nil
+}
+
+// credentialHelperAuthenticator runs an external command to get credentials for the
+// EPM API. The command prints either an API key on its own, or a JSON object with
+// either an API key or a bearer token and optionally when it expires:
+//
+//	{"key": "...", "expires_at": "2024-01-01T12:00:00Z"}
+//	{"token": "...", "expires_at": "2024-01-01T12:00:00Z"}
+//
+// Credentials are reused until they expire, or for the rest of the run if they don't.
+type credentialHelperAuthenticator struct {
+	command []string
+
+	mu         sync.Mutex
+	credential *helperCredential
+}
+
+type helperCredential struct {
+	Key       string    `json:"key"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (a *credentialHelperAuthenticator) authenticate(req *http.Request) error {
+	credential, err := a.get(req.Context())
+	if err != nil {
+		return err
+	}
+
+	if credential.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+credential.Token)
+	} else {
+		req.Header.Set("x-epm-auth", credential.Key)
+	}
+
+	return nil
+}
+
+func (a *credentialHelperAuthenticator) get(ctx context.Context) (*helperCredential, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.credential != nil && (a.credential.ExpiresAt.IsZero() || time.Until(a.credential.ExpiresAt) > credentialExpiryMargin) {
+		return a.credential, nil
+	}
+
+	credential, err := runCredentialHelper(ctx, a.command)
+	if err != nil {
+		return nil, err
+	}
+
+	a.credential = credential
+
+	return credential, nil
+}
+
+func runCredentialHelper(ctx context.Context, command []string) (*helperCredential, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential helper %s failed: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	output := strings.TrimSpace(stdout.String())
+
+	if !strings.HasPrefix(output, "{") {
+		if output == "" {
+			return nil, fmt.Errorf("credential helper %s returned no credentials", command[0])
+		}
+
+		return &helperCredential{Key: output}, nil
+	}
+
+	credential := helperCredential{}
+	if err := json.Unmarshal([]byte(output), &credential); err != nil {
+		return nil, fmt.Errorf("unable to read credentials from credential helper %s: %w", command[0], err)
+	}
+
+	if credential.Key == "" && credential.Token == "" {
+		return nil, fmt.Errorf("credential helper %s returned neither a key nor a token", command[0])
+	}
+
+	return &credential, nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testTokenServer serves OAuth2 tokens that expire after expiresIn seconds, counting
+// the tokens issued. A hang of true makes it wait until the request is cancelled.
+func testTokenServer(t *testing.T, expiresIn int, hang bool, issued *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The form is read first, so the server notices the client giving up.
+		_ = r.ParseForm()
+
+		if hang {
+			<-r.Context().Done()
+			return
+		}
+
+		n := issued.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": %d}`, n, expiresIn)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func testOAuth2Authenticator(t *testing.T, tokenURL string) authenticator {
+	t.Helper()
+
+	auth, err := newAuthenticator(EndPointMonitorClientConfig{
+		OAuth2: &OAuth2Config{TokenURL: tokenURL, ClientID: "terraform", ClientSecret: "not-a-real-secret"},
+	}, http.DefaultClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return auth
+}
+
+func testAuthenticate(t *testing.T, auth authenticator, ctx context.Context) (string, error) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://epm.invalid/api/checks/list", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = auth.authenticate(req)
+
+	return req.Header.Get("Authorization"), err
+}
+
+func TestOAuth2AuthenticatorReusesTokens(t *testing.T) {
+	var issued atomic.Int32
+	auth := testOAuth2Authenticator(t, testTokenServer(t, 3600, false, &issued).URL)
+
+	for i := 0; i < 3; i++ {
+		header, err := testAuthenticate(t, auth, context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if header != "Bearer token-1" {
+			t.Errorf("got Authorization %q, want the first token", header)
+		}
+	}
+
+	// A token about to expire is replaced.
+	var shortIssued atomic.Int32
+	auth = testOAuth2Authenticator(t, testTokenServer(t, 1, false, &shortIssued).URL)
+
+	for i := 0; i < 2; i++ {
+		if _, err := testAuthenticate(t, auth, context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := shortIssued.Load(); got != 2 {
+		t.Errorf("issued %d tokens that expire within the margin, want 2", got)
+	}
+}
+
+func TestOAuth2AuthenticatorUsesRequestContext(t *testing.T) {
+	var issued atomic.Int32
+	auth := testOAuth2Authenticator(t, testTokenServer(t, 3600, true, &issued).URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	if _, err := testAuthenticate(t, auth, ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want the request's deadline", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("gave up on the token endpoint after %s, want when the request was cancelled", elapsed)
+	}
+}
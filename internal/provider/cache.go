@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// responseCache holds responses to GET requests for the life of the provider instance,
+// which is a single Terraform run, so many data sources making the same search only
+// send it once. Identical GET requests in flight at the same time are also coalesced
+// into a single request. Any other request could change what a GET would return, so
+// the cache is flushed whenever one is sent.
+type responseCache struct {
+	mu         sync.Mutex
+	entries    map[string][]byte
+	generation uint64
+	inFlight   singleflight.Group
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		entries: map[string][]byte{},
+	}
+}
+
+// get returns the response for the key, calling fetch if the response isn't already
+// cached or being fetched. The response is only kept for later calls if store is set.
+// A request coalesced with another runs with the context of whichever started first,
+// so when that one is cancelled or times out, the others fetch for themselves rather
+// than failing with it while their own contexts still have time left.
+func (r *responseCache) get(key string, store bool, fetch func() ([]byte, error), ctx context.Context) ([]byte, error) {
+	for {
+		r.mu.Lock()
+		if body, ok := r.entries[key]; ok {
+			r.mu.Unlock()
+			return body, nil
+		}
+		generation := r.generation
+		r.mu.Unlock()
+
+		fetched := false
+
+		// Requests are only coalesced with others started since the last flush.
+		body, err, _ := r.inFlight.Do(strconv.FormatUint(generation, 10)+" "+key, func() (any, error) {
+			fetched = true
+
+			body, err := fetch()
+			if err != nil {
+				return nil, err
+			}
+
+			r.mu.Lock()
+			// A flush while the request was in flight means the response may already
+			// be out of date, so it isn't kept.
+			if store && r.generation == generation {
+				r.entries[key] = body
+			}
+			r.mu.Unlock()
+
+			return body, nil
+		})
+		if err != nil {
+			if !fetched && ctx.Err() == nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		return body.([]byte), nil
+	}
+}
+
+// flush removes every cached response.
+func (r *responseCache) flush() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = map[string][]byte{}
+	r.generation++
+}
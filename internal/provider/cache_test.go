@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testFetch returns a fetch for the response cache that counts its calls, returning
+// body once release is closed.
+func testFetch(calls *atomic.Int32, body string, release <-chan struct{}) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		calls.Add(1)
+		<-release
+
+		return []byte(body), nil
+	}
+}
+
+// testJoinWait gives a request started in another goroutine time to join one already
+// in flight.
+const testJoinWait = 50 * time.Millisecond
+
+func TestResponseCacheCoalesces(t *testing.T) {
+	cache := newResponseCache()
+	ctx := context.Background()
+	release := make(chan struct{})
+
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	bodies := make([]string, 3)
+
+	for i := range bodies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			body, err := cache.get("/checks/1", true, testFetch(&calls, "check", release), ctx)
+			if err != nil {
+				t.Error(err)
+			}
+			bodies[i] = string(body)
+		}(i)
+	}
+
+	time.Sleep(testJoinWait)
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("fetched %d times, want 1", got)
+	}
+
+	for _, body := range bodies {
+		if body != "check" {
+			t.Errorf("got %q, want check", body)
+		}
+	}
+
+	// The response is now kept, so isn't fetched again.
+	if _, err := cache.get("/checks/1", true, testFetch(&calls, "check", release), ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("fetched %d times after caching, want 1", got)
+	}
+}
+
+func TestResponseCacheInvalidation(t *testing.T) {
+	cache := newResponseCache()
+	ctx := context.Background()
+	released := make(chan struct{})
+	close(released)
+
+	var calls atomic.Int32
+
+	get := func(store bool) {
+		t.Helper()
+
+		if _, err := cache.get("/checks/list", store, testFetch(&calls, "[]", released), ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	get(false)
+	get(false)
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("fetched %d times without storing, want 2", got)
+	}
+
+	get(true)
+	get(true)
+
+	if got := calls.Load(); got != 3 {
+		t.Errorf("fetched %d times once stored, want 3", got)
+	}
+
+	cache.flush()
+	get(true)
+
+	if got := calls.Load(); got != 4 {
+		t.Errorf("fetched %d times after a flush, want 4", got)
+	}
+
+	// A response fetched while the cache is flushed may be out of date, so isn't kept.
+	_, err := cache.get("/checks/1", true, func() ([]byte, error) {
+		cache.flush()
+		return []byte("{}"), nil
+	}, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := cache.entries["/checks/1"]; ok {
+		t.Error("response fetched across a flush was kept")
+	}
+}
+
+func TestResponseCacheLeaderCancelled(t *testing.T) {
+	cache := newResponseCache()
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderStarted := make(chan struct{})
+
+	var calls atomic.Int32
+	var leaderErr error
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_, leaderErr = cache.get("/checks/1", true, func() ([]byte, error) {
+			calls.Add(1)
+			close(leaderStarted)
+			<-leaderCtx.Done()
+
+			return nil, leaderCtx.Err()
+		}, leaderCtx)
+	}()
+
+	<-leaderStarted
+
+	followerDone := make(chan struct{})
+	var body []byte
+	var followerErr error
+
+	go func() {
+		defer close(followerDone)
+
+		body, followerErr = cache.get("/checks/1", true, func() ([]byte, error) {
+			calls.Add(1)
+			return []byte("check"), nil
+		}, context.Background())
+	}()
+
+	time.Sleep(testJoinWait)
+	cancel()
+	<-done
+	<-followerDone
+
+	if !errors.Is(leaderErr, context.Canceled) {
+		t.Errorf("leader got %v, want its cancellation", leaderErr)
+	}
+
+	if followerErr != nil || string(body) != "check" {
+		t.Errorf("follower got %q, %v, want its own response", body, followerErr)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("fetched %d times, want 2", got)
+	}
+
+	// A request failing with its own cancellation isn't tried again.
+	ended, cancelEnded := context.WithCancel(context.Background())
+	cancelEnded()
+
+	if _, err := cache.get("/checks/2", true, func() ([]byte, error) { return nil, context.Canceled }, ended); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want the cancellation", err)
+	}
+}
+
+func TestClientFlushesCacheOnWrite(t *testing.T) {
+	server := testAccFake(t)
+	ctx := context.Background()
+
+	client, err := NewEPMClient(EndPointMonitorClientConfig{HostURL: server.APIURL(), ApiKey: testAccKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	search := func() []types.Int32 {
+		t.Helper()
+
+		ids, err := client.SearchDashboardGroups("", 0, ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return ids
+	}
+
+	search()
+	search()
+
+	// The second search is answered from the cache.
+	if got := countRequests(server.Requests(), "GET /api/dashboardGroups/list"); got != 1 {
+		t.Errorf("got %d list requests for two searches, want 1", got)
+	}
+
+	if _, err := client.CreateDashboardGroup(DashboardGroupModel{Name: types.StringValue("Operations"), Description: types.StringValue("")}, ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := search(); len(ids) != 1 {
+		t.Errorf("search after adding a group found %d, want 1", len(ids))
+	}
+
+	if got := countRequests(server.Requests(), "GET /api/dashboardGroups/list"); got != 3 {
+		t.Errorf("got %d list requests, want the search of both pages repeated after the add", got)
+	}
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Capabilities reported by the server are named after the kind of item and its type
+// as sent to the API, such as "webJourneyAction:SCROLL_TO_ELEMENT".
+const (
+	capabilityWebJourneyAction    = "webJourneyAction:"
+	capabilityWebJourneyPageCheck = "webJourneyPageCheck:"
+	capabilityAndroidAction       = "androidAction:"
+	capabilityAndroidStepCheck    = "androidStepCheck:"
+)
+
+// featureUse is a use of a feature, that not every version of EPM supports, in a
+// resource's configuration.
+type featureUse struct {
+	path       path.Path
+	capability string
+}
+
+// supports reports whether the server supports a capability, and whether that is
+// known at all. It can only be known if the server listed its capabilities during
+// the health check.
+func (c *EndPointMonitorClient) supports(capability string) (supported bool, known bool) {
+	if c.ServerInfo == nil || c.ServerInfo.Capabilities == nil {
+		return false, false
+	}
+
+	return slices.Contains(c.ServerInfo.Capabilities, capability), true
+}
+
+// checkFeatures adds an error for each use of a feature the server doesn't support.
+// That can only be known when the server lists its capabilities; otherwise a warning
+// is added the first time each feature is used, as the server will reject the use
+// when applied if it doesn't support it.
+//
+// Resources only check the features used once their plan can be read, which it can't
+// while it has values that aren't known until apply. They are checked then instead,
+// when the plan is made again.
+func (c *EndPointMonitorClient) checkFeatures(ctx context.Context, uses []featureUse, diags *diag.Diagnostics) {
+	for _, use := range uses {
+		supported, known := c.supports(use.capability)
+
+		if known && !supported {
+			diags.AddAttributeError(
+				use.path,
+				"Feature Not Supported by EndPointMonitor",
+				"The connected EndPoint Monitor server, version "+c.ServerInfo.Version+", doesn't support "+
+					describeCapability(use.capability)+". Upgrade EndPoint Monitor to use it.",
+			)
+			continue
+		}
+
+		if known {
+			continue
+		}
+
+		tflog.Debug(ctx, "Unable to confirm EndPoint Monitor supports feature", map[string]any{"capability": use.capability})
+
+		if _, warned := c.warnedCapabilities.LoadOrStore(use.capability, true); warned {
+			continue
+		}
+
+		server := "The connected EndPoint Monitor server doesn't report which features it supports, or the health check that finds out was skipped"
+		if c.ServerInfo != nil {
+			server = "The connected EndPoint Monitor server, version " + c.ServerInfo.Version + ", doesn't report which features it supports"
+		}
+
+		diags.AddAttributeWarning(
+			use.path,
+			"Feature Support Unknown",
+			server+", so it can't be confirmed that it supports "+describeCapability(use.capability)+
+				". The server will reject it when applied if it doesn't.",
+		)
+	}
+}
+
+func describeCapability(capability string) string {
+	kind, name, _ := strings.Cut(capability, ":")
+	name = strings.ToLower(name)
+
+	switch kind + ":" {
+	case capabilityWebJourneyAction:
+		return "the " + name + " web journey action"
+	case capabilityWebJourneyPageCheck:
+		return "the " + name + " web journey page check"
+	case capabilityAndroidAction:
+		return "the " + name + " Android journey step interaction"
+	case capabilityAndroidStepCheck:
+		return "the " + name + " Android journey step check"
+	default:
+		return "the " + capability + " feature"
+	}
+}
+
+// webJourneyStepFeatures lists the features used by the page checks and actions of a
+// web journey step.
+func webJourneyStepFeatures(stepPath path.Path, pageChecks []WebJourneyPageCheckModel, actions []WebJourneyActionModel) []featureUse {
+	uses := []featureUse{}
+
+	for i, pageCheck := range pageChecks {
+		if pageCheck.Type.ValueString() != "" {
+			uses = append(uses, featureUse{
+				path:       stepPath.AtName("page_check").AtListIndex(i).AtName("type"),
+				capability: capabilityWebJourneyPageCheck + pageCheck.Type.ValueString(),
+			})
+		}
+	}
+
+	for i, action := range actions {
+		if action.Type.ValueString() != "" {
+			uses = append(uses, featureUse{
+				path:       stepPath.AtName("action").AtListIndex(i).AtName("type"),
+				capability: capabilityWebJourneyAction + action.Type.ValueString(),
+			})
+		}
+	}
+
+	return uses
+}
+
+// androidJourneyStepFeatures lists the features used by the step checks and step
+// interactions of an Android journey step.
+func androidJourneyStepFeatures(stepPath path.Path, stepChecks []AndroidStepCheckModel, interactions []AndroidStepInteractionModel) []featureUse {
+	uses := []featureUse{}
+
+	for i, stepCheck := range stepChecks {
+		if stepCheck.Type.ValueString() != "" {
+			uses = append(uses, featureUse{
+				path:       stepPath.AtName("step_check").AtListIndex(i).AtName("type"),
+				capability: capabilityAndroidStepCheck + stepCheck.Type.ValueString(),
+			})
+		}
+	}
+
+	for i, interaction := range interactions {
+		if interaction.Type.ValueString() != "" {
+			uses = append(uses, featureUse{
+				path:       stepPath.AtName("step_interaction").AtListIndex(i).AtName("type"),
+				capability: capabilityAndroidAction + interaction.Type.ValueString(),
+			})
+		}
+	}
+
+	return uses
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestCheckFeatures(t *testing.T) {
+	uses := []featureUse{
+		{path: path.Root("action").AtListIndex(0).AtName("type"), capability: capabilityWebJourneyAction + "CLICK"},
+		{path: path.Root("action").AtListIndex(1).AtName("type"), capability: capabilityWebJourneyAction + "SCROLL_TO_ELEMENT"},
+	}
+
+	tests := map[string]struct {
+		info         bool
+		capabilities []string
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		"all supported": {
+			info:         true,
+			capabilities: []string{"webJourneyAction:CLICK", "webJourneyAction:SCROLL_TO_ELEMENT"},
+		},
+		"not supported": {
+			info:         true,
+			capabilities: []string{"webJourneyAction:CLICK"},
+			wantErrors:   []string{"action[1].type"},
+		},
+		"capabilities not reported": {
+			info:         true,
+			wantWarnings: []string{"action[0].type", "action[1].type"},
+		},
+		"no server info": {
+			wantWarnings: []string{"action[0].type", "action[1].type"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := testAccFake(t)
+			if test.info {
+				server.SetInfo("5.1.0", test.capabilities)
+			}
+
+			client, healthDiags := testHealthCheck(t, server.APIURL(), testAccKey)
+			if healthDiags.HasError() {
+				t.Fatal(healthDiags)
+			}
+
+			var diags diag.Diagnostics
+			client.checkFeatures(context.Background(), uses, &diags)
+
+			if got := testDiagnosticPaths(diags.Errors()); !slices.Equal(got, test.wantErrors) {
+				t.Errorf("got errors at %q, want %q", got, test.wantErrors)
+			}
+
+			if got := testDiagnosticPaths(diags.Warnings()); !slices.Equal(got, test.wantWarnings) {
+				t.Errorf("got warnings at %q, want %q", got, test.wantWarnings)
+			}
+
+			// Each feature of unknown support is only warned about once.
+			diags = nil
+			client.checkFeatures(context.Background(), uses, &diags)
+
+			if len(diags.Warnings()) != 0 {
+				t.Errorf("got %d warnings when planning again, want none", len(diags.Warnings()))
+			}
+		})
+	}
+}
+
+func TestAccFeaturesCheckedWhenPlanning(t *testing.T) {
+	server := testAccFake(t)
+
+	config := testAccConfig(server, `
+resource "endpointmonitor_web_journey_common_step" "test" {
+  name                   = "Test Web Journey Common Step"
+  description            = "Test web journey common step."
+  wait_time              = 5000
+  page_load_time_warning = 2500
+  page_load_time_alert   = 5000
+
+  action {
+    sequence        = 1
+    description     = "Accept cookies"
+    always_required = false
+    type            = "CLICK"
+
+    click {
+      search_text  = "Accept"
+      element_type = "button"
+    }
+  }
+}
+`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.WebJourneyCommonSteps),
+		Steps: []resource.TestStep{
+			{
+				PreConfig:   func() { server.SetInfo("5.0.0", []string{"webJourneyAction:TEXT_INPUT"}) },
+				Config:      config,
+				ExpectError: regexp.MustCompile(`version 5\.0\.0, doesn't support the\s+click web journey action`),
+			},
+			{
+				PreConfig: func() { server.SetInfo("5.1.0", []string{"webJourneyAction:TEXT_INPUT", "webJourneyAction:CLICK"}) },
+				Config:    config,
+				Check:     resource.TestCheckResourceAttr("endpointmonitor_web_journey_common_step.test", "action.0.type", "CLICK"),
+			},
+		},
+	})
+}
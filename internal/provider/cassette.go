@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+
+	"terraform-provider-endpointmonitor/internal/cassette"
+)
+
+// cassetteRecorders are the cassettes being recorded by the clients a provider has
+// configured, which are written by CloseCassettes.
+type cassetteRecorders struct {
+	mu        sync.Mutex
+	recorders []*cassette.Recorder
+}
+
+func (r *cassetteRecorders) add(recorder *cassette.Recorder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recorders = append(r.recorders, recorder)
+}
+
+func (r *cassetteRecorders) close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs []error
+	for _, recorder := range r.recorders {
+		errs = append(errs, recorder.Close())
+	}
+
+	r.recorders = nil
+
+	return errors.Join(errs...)
+}
+
+// buildCassetteTransport wraps the transport used to talk to the EPM API so its
+// traffic is recorded to, or replayed from, a cassette file when one is given. It
+// returns the transport unchanged otherwise.
+func buildCassetteTransport(config EndPointMonitorClientConfig, transport http.RoundTripper) (http.RoundTripper, error) {
+	switch {
+	case config.RecordCassette != "" && config.ReplayCassette != "":
+		return nil, fmt.Errorf("a cassette can't be recorded and replayed at the same time")
+	case config.RecordCassette != "":
+		return cassette.NewRecorder(config.RecordCassette, transport), nil
+	case config.ReplayCassette != "":
+		c, err := cassette.Load(config.ReplayCassette)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load cassette: %w", err)
+		}
+
+		return cassette.NewReplayer(c), nil
+	default:
+		return transport, nil
+	}
+}
+
+// CloseCassettes writes any cassettes being recorded by clients the provider has
+// configured. Terraform gives a provider no notice it is about to stop, so it is
+// called once the provider server has stopped.
+func CloseCassettes(p provider.Provider) error {
+	epmProvider, ok := p.(*endPointMonitorProvider)
+	if !ok {
+		return nil
+	}
+
+	return epmProvider.cassettes.close()
+}
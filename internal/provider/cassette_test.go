@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/cassette"
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+var update = flag.Bool("update", false, "rewrite the recorded cassettes in testdata")
+
+// testCassettePath is the cassette TestClientReplaysCassette replays, recorded from
+// the fake with -update.
+var testCassettePath = filepath.Join("testdata", "cassettes", "client.json")
+
+// testCassetteSecrets are sent by testCassetteTraffic and must never be recorded.
+var testCassetteSecrets = []string{"Bearer not-a-real-token", "not-a-real-password"}
+
+// testCassetteTraffic creates and reads back a URL check, sending an Authorization
+// header, and a web journey common step, entering a password.
+func testCassetteTraffic(t *testing.T, client *EndPointMonitorClient) (*UrlCheckModel, *WebJourneyCommonStepModel) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	urlCheck := testUrlCheckModel()
+	urlCheck.RequestHeader[0].Name = types.StringValue("Authorization")
+	urlCheck.RequestHeader[0].Value = types.StringValue(testCassetteSecrets[0])
+
+	created, err := client.CreateUrlCheck(urlCheck, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readUrlCheck, err := client.GetUrlCheck(created.Id.ValueInt64(), ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	step := testWebJourneyCommonStepModel()
+	for _, action := range step.Actions {
+		if action.PasswordInput != nil {
+			action.PasswordInput.InputPassword = types.StringValue(testCassetteSecrets[1])
+		}
+	}
+
+	createdStep, err := client.CreateWebJourneyCommonStep(step, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readStep, err := client.GetCommonWebJourneyStep(createdStep.Id.ValueInt64(), ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return readUrlCheck, readStep
+}
+
+func TestClientRecordsCassette(t *testing.T) {
+	server := testAccFake(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	client, err := NewEPMClient(EndPointMonitorClientConfig{
+		HostURL:        server.APIURL(),
+		ApiKey:         testAccKey,
+		RecordCassette: path,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCassetteTraffic(t, client)
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("cassette written before the recorder was closed: %v", err)
+	}
+
+	if err := client.HTTPClient.Transport.(*cassette.Recorder).Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	recorded, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range append(testCassetteSecrets, testAccKey) {
+		if bytes.Contains(recorded, []byte(secret)) {
+			t.Errorf("cassette holds the secret %q", secret)
+		}
+	}
+
+	c, err := cassette.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Interactions) != 4 {
+		t.Errorf("got %d interactions, want 4", len(c.Interactions))
+	}
+
+	if *update {
+		if err := os.WriteFile(testCassettePath, recorded, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestClientReplaysCassette(t *testing.T) {
+	client, err := NewEPMClient(EndPointMonitorClientConfig{
+		HostURL:        "https://epm.invalid/api",
+		ApiKey:         "any",
+		ReplayCassette: testCassettePath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	urlCheck, step := testCassetteTraffic(t, client)
+
+	if remaining := client.HTTPClient.Transport.(*cassette.Replayer).Remaining(); remaining != 0 {
+		t.Errorf("%d recorded responses weren't replayed", remaining)
+	}
+
+	want := testUrlCheckModel()
+	want.Id = urlCheck.Id
+
+	if diff := cmp.Diff(want, *urlCheck, modelComparer); diff != "" {
+		t.Errorf("replayed URL check differs (-want +got):\n%s", diff)
+	}
+
+	var password string
+	for _, action := range step.Actions {
+		if action.PasswordInput != nil {
+			password = action.PasswordInput.InputPassword.ValueString()
+		}
+	}
+
+	if password != cassette.Redacted {
+		t.Errorf("replayed password is %q, want %q", password, cassette.Redacted)
+	}
+
+	if name := step.Name.ValueString(); name != testWebJourneyCommonStepModel().Name.ValueString() {
+		t.Errorf("replayed common step is %q", name)
+	}
+}
+
+// testRecordingClient returns a client for the fake that records to the cassette at
+// path.
+func testRecordingClient(t *testing.T, server *fakeepm.Server, path string) *EndPointMonitorClient {
+	t.Helper()
+
+	client, err := NewEPMClient(EndPointMonitorClientConfig{
+		HostURL:        server.APIURL(),
+		ApiKey:         testAccKey,
+		RecordCassette: path,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// TestRecordersAddToCassette checks clients recording to the same cassette, as the
+// processes Terraform runs for plan and apply, or for each provider configuration,
+// do, each add their traffic to it.
+func TestRecordersAddToCassette(t *testing.T) {
+	server := testAccFake(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	plan := testRecordingClient(t, server, path)
+	apply := testRecordingClient(t, server, path)
+
+	testCassetteTraffic(t, plan)
+	testCassetteTraffic(t, apply)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+
+	for i, client := range []*EndPointMonitorClient{plan, apply} {
+		wg.Add(1)
+		go func(i int, recorder *cassette.Recorder) {
+			defer wg.Done()
+			errs[i] = recorder.Close()
+		}(i, client.recorder)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		t.Fatal(err)
+	}
+
+	// Closing again only adds what was recorded since.
+	if err := plan.recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cassette.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Interactions) != 8 {
+		t.Errorf("got %d interactions, want the 4 of each client", len(c.Interactions))
+	}
+
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("cassette left locked: %v", err)
+	}
+}
+
+// TestAccProviderClosesItsCassettes checks each provider only writes the cassettes of
+// the clients it configured.
+func TestAccProviderClosesItsCassettes(t *testing.T) {
+	server := testAccFake(t)
+	server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Operations", "description": "Operations dashboard."})
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	t.Setenv("EPM_RECORD_CASSETTE", path)
+
+	recording := New("test")()
+	other := New("test")()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"endpointmonitor": providerserver.NewProtocol6WithError(recording),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `data "endpointmonitor_dashboard_groups" "all" {}`),
+				Check:  resource.TestCheckResourceAttr("data.endpointmonitor_dashboard_groups.all", "ids.#", "1"),
+			},
+		},
+	})
+
+	if err := CloseCassettes(other); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("cassette written by a provider that didn't record it: %v", err)
+	}
+
+	if err := CloseCassettes(recording); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cassette.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Interactions) == 0 {
+		t.Error("cassette recorded no interactions")
+	}
+}
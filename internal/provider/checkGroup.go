@@ -28,3 +28,12 @@ func mapToCheckGroupModel(checkGroup CheckGroup) CheckGroupModel {
 		DashboardGroup: types.Int32Value(int32(checkGroup.DashboardGroup.Id)),
 	}
 }
+
+func mapToCheckGroupSummaryModel(checkGroup CheckGroup) CheckGroupSummaryModel {
+	return CheckGroupSummaryModel{
+		Id:               types.Int32Value(int32(checkGroup.Id)),
+		Name:             types.StringValue(checkGroup.Name),
+		Description:      types.StringValue(checkGroup.Description),
+		DashboardGroupId: types.Int32Value(int32(checkGroup.DashboardGroup.Id)),
+	}
+}
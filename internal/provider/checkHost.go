@@ -35,3 +35,13 @@ func mapToCheckHostModel(checkHost CheckHost) CheckHostModel {
 		SendCheckFiles:      types.BoolValue(checkHost.SendCheckFiles),
 	}
 }
+
+func mapToCheckHostSummaryModel(checkHost CheckHost) CheckHostSummaryModel {
+	return CheckHostSummaryModel{
+		Id:          types.Int32Value(int32(checkHost.Id)),
+		Hostname:    types.StringValue(checkHost.Hostname),
+		Description: types.StringValue(checkHost.Description),
+		Type:        types.StringPointerValue(checkHost.Type),
+		Enabled:     types.BoolValue(checkHost.Enabled),
+	}
+}
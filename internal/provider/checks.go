@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"sort"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -403,7 +405,7 @@ func mapToCertificateCheck(checkModel CertificateCheckModel) CertificateCheck {
 		check.CheckHost = &CheckHost{Id: int(checkModel.CheckHostId.ValueInt32())}
 	}
 
-	if !checkModel.CheckGroupId.IsNull() {
+	if !checkModel.HostGroupId.IsNull() {
 		check.HostGroup = &HostGroup{Id: int(checkModel.HostGroupId.ValueInt32())}
 	}
 
@@ -437,7 +439,7 @@ func mapToDnsCheck(checkModel DnsCheckModel) DnsCheck {
 		check.CheckHost = &CheckHost{Id: int(checkModel.CheckHostId.ValueInt32())}
 	}
 
-	if !checkModel.CheckGroupId.IsNull() {
+	if !checkModel.HostGroupId.IsNull() {
 		check.HostGroup = &HostGroup{Id: int(checkModel.HostGroupId.ValueInt32())}
 	}
 
@@ -472,7 +474,7 @@ func mapToPingCheck(checkModel PingCheckModel) PingCheck {
 		check.CheckHost = &CheckHost{Id: int(checkModel.CheckHostId.ValueInt32())}
 	}
 
-	if !checkModel.CheckGroupId.IsNull() {
+	if !checkModel.HostGroupId.IsNull() {
 		check.HostGroup = &HostGroup{Id: int(checkModel.HostGroupId.ValueInt32())}
 	}
 
@@ -504,7 +506,7 @@ func mapToSocketCheck(checkModel SocketCheckModel) SocketCheck {
 		check.CheckHost = &CheckHost{Id: int(checkModel.CheckHostId.ValueInt32())}
 	}
 
-	if !checkModel.CheckGroupId.IsNull() {
+	if !checkModel.HostGroupId.IsNull() {
 		check.HostGroup = &HostGroup{Id: int(checkModel.HostGroupId.ValueInt32())}
 	}
 
@@ -535,7 +537,7 @@ func mapToUrlCheck(checkModel UrlCheckModel) UrlCheck {
 		check.CheckHost = &CheckHost{Id: int(checkModel.CheckHostId.ValueInt32())}
 	}
 
-	if !checkModel.CheckGroupId.IsNull() {
+	if !checkModel.HostGroupId.IsNull() {
 		check.HostGroup = &HostGroup{Id: int(checkModel.HostGroupId.ValueInt32())}
 	}
 
@@ -582,13 +584,13 @@ func mapToAndroidJourneyCheck(checkModel AndroidJourneyCheckModel) AndroidJourne
 		check.CheckHost = &CheckHost{Id: int(checkModel.CheckHostId.ValueInt32())}
 	}
 
-	if !checkModel.CheckGroupId.IsNull() {
+	if !checkModel.HostGroupId.IsNull() {
 		check.HostGroup = &HostGroup{Id: int(checkModel.HostGroupId.ValueInt32())}
 	}
 
-	//	if !checkModel.ProxyHostId.IsNull() {
-	//		check.ProxyHost = &ProxyHost{Id: int(checkModel.ProxyHostId.ValueInt32())}
-	//	}
+	if !checkModel.ProxyHostId.IsNull() {
+		check.ProxyHost = &ProxyHost{Id: int(checkModel.ProxyHostId.ValueInt32())}
+	}
 
 	check.Apk = checkModel.Apk.ValueString()
 	check.ApkChecksum = checkModel.ApkChecksum.ValueString()
@@ -841,7 +843,7 @@ func mapToWebJourneyCheck(checkModel WebJourneyCheckModel) WebJourneyCheck {
 		check.CheckHost = &CheckHost{Id: int(checkModel.CheckHostId.ValueInt32())}
 	}
 
-	if !checkModel.CheckGroupId.IsNull() {
+	if !checkModel.HostGroupId.IsNull() {
 		check.HostGroup = &HostGroup{Id: int(checkModel.HostGroupId.ValueInt32())}
 	}
 
@@ -974,40 +976,50 @@ func mapToWebJourneyCheck(checkModel WebJourneyCheckModel) WebJourneyCheck {
 
 			switch action.Type {
 			case "CLICK":
-				action.WebJourneyClickAction = &WebJourneyClickAction{
-					Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
-					ElementType: actionModel.Click.ElementType.ValueStringPointer(),
-					SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+				if actionModel.Click != nil {
+					action.WebJourneyClickAction = &WebJourneyClickAction{
+						Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
+						ElementType: actionModel.Click.ElementType.ValueStringPointer(),
+						SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+					}
 				}
 
 			case "DOUBLE_CLICK":
-				action.WebJourneyDoubleClickAction = &WebJourneyClickAction{
-					Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
-					ElementType: actionModel.Click.ElementType.ValueStringPointer(),
-					SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+				if actionModel.Click != nil {
+					action.WebJourneyDoubleClickAction = &WebJourneyClickAction{
+						Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
+						ElementType: actionModel.Click.ElementType.ValueStringPointer(),
+						SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+					}
 				}
 
 			case "RIGHT_CLICK":
-				action.WebJourneyRightClickAction = &WebJourneyClickAction{
-					Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
-					ElementType: actionModel.Click.ElementType.ValueStringPointer(),
-					SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+				if actionModel.Click != nil {
+					action.WebJourneyRightClickAction = &WebJourneyClickAction{
+						Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
+						ElementType: actionModel.Click.ElementType.ValueStringPointer(),
+						SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+					}
 				}
 
 			case "TEXT_INPUT":
-				action.WebJourneyTextInputAction = &WebJourneyTextInputAction{
-					Xpath:       actionModel.TextInput.Xpath.ValueStringPointer(),
-					ElementId:   actionModel.TextInput.ElementId.ValueStringPointer(),
-					ElementName: actionModel.TextInput.ElementName.ValueStringPointer(),
-					InputText:   actionModel.TextInput.InputText.ValueString(),
+				if actionModel.TextInput != nil {
+					action.WebJourneyTextInputAction = &WebJourneyTextInputAction{
+						Xpath:       actionModel.TextInput.Xpath.ValueStringPointer(),
+						ElementId:   actionModel.TextInput.ElementId.ValueStringPointer(),
+						ElementName: actionModel.TextInput.ElementName.ValueStringPointer(),
+						InputText:   actionModel.TextInput.InputText.ValueString(),
+					}
 				}
 
 			case "PASSWORD_INPUT":
-				action.WebJourneyPasswordInputAction = &WebJourneyPasswordInputAction{
-					Xpath:       actionModel.PasswordInput.Xpath.ValueStringPointer(),
-					ElementId:   actionModel.PasswordInput.ElementId.ValueStringPointer(),
-					ElementName: actionModel.PasswordInput.ElementName.ValueStringPointer(),
-					NewPassword: actionModel.PasswordInput.InputPassword.ValueString(),
+				if actionModel.PasswordInput != nil {
+					action.WebJourneyPasswordInputAction = &WebJourneyPasswordInputAction{
+						Xpath:       actionModel.PasswordInput.Xpath.ValueStringPointer(),
+						ElementId:   actionModel.PasswordInput.ElementId.ValueStringPointer(),
+						ElementName: actionModel.PasswordInput.ElementName.ValueStringPointer(),
+						NewPassword: actionModel.PasswordInput.InputPassword.ValueString(),
+					}
 				}
 
 			case "CHANGE_WINDOW_BY_ORDER":
@@ -1041,19 +1053,23 @@ func mapToWebJourneyCheck(checkModel WebJourneyCheckModel) WebJourneyCheck {
 				}
 
 			case "SCROLL_TO_ELEMENT":
-				action.WebJourneyScrollToElement = &WebJourneyScrollToElement{
-					Xpath:       actionModel.ScrollToElement.Xpath.ValueStringPointer(),
-					SearchText:  actionModel.ScrollToElement.SearchText.ValueStringPointer(),
-					ElementType: actionModel.ScrollToElement.ElementType.ValueStringPointer(),
+				if actionModel.ScrollToElement != nil {
+					action.WebJourneyScrollToElement = &WebJourneyScrollToElement{
+						Xpath:       actionModel.ScrollToElement.Xpath.ValueStringPointer(),
+						SearchText:  actionModel.ScrollToElement.SearchText.ValueStringPointer(),
+						ElementType: actionModel.ScrollToElement.ElementType.ValueStringPointer(),
+					}
 				}
 
 			case "SELECT_OPTION":
-				action.WebJourneySelectOption = &WebJourneySelectOption{
-					ElementId:   actionModel.SelectOption.ElementId.ValueStringPointer(),
-					Xpath:       actionModel.SelectOption.Xpath.ValueStringPointer(),
-					OptionIndex: actionModel.SelectOption.OptionIndex.ValueInt32Pointer(),
-					OptionName:  actionModel.SelectOption.OptionName.ValueStringPointer(),
-					OptionValue: actionModel.SelectOption.OptionName.ValueStringPointer(),
+				if actionModel.SelectOption != nil {
+					action.WebJourneySelectOption = &WebJourneySelectOption{
+						ElementId:   actionModel.SelectOption.ElementId.ValueStringPointer(),
+						Xpath:       actionModel.SelectOption.Xpath.ValueStringPointer(),
+						OptionIndex: actionModel.SelectOption.OptionIndex.ValueInt32Pointer(),
+						OptionName:  actionModel.SelectOption.OptionName.ValueStringPointer(),
+						OptionValue: actionModel.SelectOption.OptionValue.ValueStringPointer(),
+					}
 				}
 			}
 
@@ -1091,7 +1107,7 @@ func mapToWebJourneyCommonStep(stepModel WebJourneyCommonStepModel) WebJourneyCo
 			}
 		}
 
-		if pageCheck.PageCheckForElement != nil {
+		if pageCheckModel.PageCheckForElement != nil {
 			pageCheck.PageCheckForElement = &PageCheckForElement{
 				Id:             int(pageCheckModel.PageCheckForElement.Id.ValueInt64()),
 				ElementId:      pageCheckModel.PageCheckForElement.ElementId.ValueStringPointer(),
@@ -1103,7 +1119,7 @@ func mapToWebJourneyCommonStep(stepModel WebJourneyCommonStepModel) WebJourneyCo
 			}
 		}
 
-		if pageCheck.PageCheckCurrentURL != nil {
+		if pageCheckModel.PageCheckCurrentURL != nil {
 			pageCheck.PageCheckCurrentURL = &PageCheckCurrentURL{
 				Id:         int(pageCheckModel.PageCheckCurrentURL.Id.ValueInt64()),
 				Url:        pageCheckModel.PageCheckCurrentURL.Url.ValueString(),
@@ -1111,7 +1127,7 @@ func mapToWebJourneyCommonStep(stepModel WebJourneyCommonStepModel) WebJourneyCo
 			}
 		}
 
-		if pageCheck.PageCheckURLResponse != nil {
+		if pageCheckModel.PageCheckURLResponse != nil {
 			pageCheck.PageCheckURLResponse = &PageCheckURLResponse{
 				Id:                     int(pageCheckModel.PageCheckURLResponse.Id.ValueInt64()),
 				Url:                    pageCheckModel.PageCheckURLResponse.Url.ValueString(),
@@ -1127,7 +1143,7 @@ func mapToWebJourneyCommonStep(stepModel WebJourneyCommonStepModel) WebJourneyCo
 			}
 		}
 
-		if pageCheck.PageCheckConsoleLog != nil {
+		if pageCheckModel.PageCheckConsoleLog != nil {
 			pageCheck.PageCheckConsoleLog = &PageCheckConsoleLog{
 				Id:         int(pageCheckModel.PageCheckConsoleLog.Id.ValueInt64()),
 				LogLevel:   pageCheckModel.PageCheckConsoleLog.LogLevel.ValueString(),
@@ -1178,40 +1194,50 @@ func mapToWebJourneyCommonStep(stepModel WebJourneyCommonStepModel) WebJourneyCo
 
 		switch action.Type {
 		case "CLICK":
-			action.WebJourneyClickAction = &WebJourneyClickAction{
-				Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
-				ElementType: actionModel.Click.ElementType.ValueStringPointer(),
-				SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+			if actionModel.Click != nil {
+				action.WebJourneyClickAction = &WebJourneyClickAction{
+					Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
+					ElementType: actionModel.Click.ElementType.ValueStringPointer(),
+					SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+				}
 			}
 
 		case "DOUBLE_CLICK":
-			action.WebJourneyDoubleClickAction = &WebJourneyClickAction{
-				Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
-				ElementType: actionModel.Click.ElementType.ValueStringPointer(),
-				SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+			if actionModel.Click != nil {
+				action.WebJourneyDoubleClickAction = &WebJourneyClickAction{
+					Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
+					ElementType: actionModel.Click.ElementType.ValueStringPointer(),
+					SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+				}
 			}
 
 		case "RIGHT_CLICK":
-			action.WebJourneyRightClickAction = &WebJourneyClickAction{
-				Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
-				ElementType: actionModel.Click.ElementType.ValueStringPointer(),
-				SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+			if actionModel.Click != nil {
+				action.WebJourneyRightClickAction = &WebJourneyClickAction{
+					Xpath:       actionModel.Click.Xpath.ValueStringPointer(),
+					ElementType: actionModel.Click.ElementType.ValueStringPointer(),
+					SearchText:  actionModel.Click.SearchText.ValueStringPointer(),
+				}
 			}
 
 		case "TEXT_INPUT":
-			action.WebJourneyTextInputAction = &WebJourneyTextInputAction{
-				Xpath:       actionModel.TextInput.Xpath.ValueStringPointer(),
-				ElementId:   actionModel.TextInput.ElementId.ValueStringPointer(),
-				ElementName: actionModel.TextInput.ElementName.ValueStringPointer(),
-				InputText:   actionModel.TextInput.InputText.ValueString(),
+			if actionModel.TextInput != nil {
+				action.WebJourneyTextInputAction = &WebJourneyTextInputAction{
+					Xpath:       actionModel.TextInput.Xpath.ValueStringPointer(),
+					ElementId:   actionModel.TextInput.ElementId.ValueStringPointer(),
+					ElementName: actionModel.TextInput.ElementName.ValueStringPointer(),
+					InputText:   actionModel.TextInput.InputText.ValueString(),
+				}
 			}
 
 		case "PASSWORD_INPUT":
-			action.WebJourneyPasswordInputAction = &WebJourneyPasswordInputAction{
-				Xpath:       actionModel.PasswordInput.Xpath.ValueStringPointer(),
-				ElementId:   actionModel.PasswordInput.ElementId.ValueStringPointer(),
-				ElementName: actionModel.PasswordInput.ElementName.ValueStringPointer(),
-				NewPassword: actionModel.PasswordInput.InputPassword.ValueString(),
+			if actionModel.PasswordInput != nil {
+				action.WebJourneyPasswordInputAction = &WebJourneyPasswordInputAction{
+					Xpath:       actionModel.PasswordInput.Xpath.ValueStringPointer(),
+					ElementId:   actionModel.PasswordInput.ElementId.ValueStringPointer(),
+					ElementName: actionModel.PasswordInput.ElementName.ValueStringPointer(),
+					NewPassword: actionModel.PasswordInput.InputPassword.ValueString(),
+				}
 			}
 
 		case "CHANGE_WINDOW_BY_ORDER":
@@ -1245,19 +1271,23 @@ func mapToWebJourneyCommonStep(stepModel WebJourneyCommonStepModel) WebJourneyCo
 			}
 
 		case "SCROLL_TO_ELEMENT":
-			action.WebJourneyScrollToElement = &WebJourneyScrollToElement{
-				Xpath:       actionModel.ScrollToElement.Xpath.ValueStringPointer(),
-				SearchText:  actionModel.ScrollToElement.SearchText.ValueStringPointer(),
-				ElementType: actionModel.ScrollToElement.ElementType.ValueStringPointer(),
+			if actionModel.ScrollToElement != nil {
+				action.WebJourneyScrollToElement = &WebJourneyScrollToElement{
+					Xpath:       actionModel.ScrollToElement.Xpath.ValueStringPointer(),
+					SearchText:  actionModel.ScrollToElement.SearchText.ValueStringPointer(),
+					ElementType: actionModel.ScrollToElement.ElementType.ValueStringPointer(),
+				}
 			}
 
 		case "SELECT_OPTION":
-			action.WebJourneySelectOption = &WebJourneySelectOption{
-				ElementId:   actionModel.SelectOption.ElementId.ValueStringPointer(),
-				Xpath:       actionModel.SelectOption.Xpath.ValueStringPointer(),
-				OptionIndex: actionModel.SelectOption.OptionIndex.ValueInt32Pointer(),
-				OptionName:  actionModel.SelectOption.OptionName.ValueStringPointer(),
-				OptionValue: actionModel.SelectOption.OptionName.ValueStringPointer(),
+			if actionModel.SelectOption != nil {
+				action.WebJourneySelectOption = &WebJourneySelectOption{
+					ElementId:   actionModel.SelectOption.ElementId.ValueStringPointer(),
+					Xpath:       actionModel.SelectOption.Xpath.ValueStringPointer(),
+					OptionIndex: actionModel.SelectOption.OptionIndex.ValueInt32Pointer(),
+					OptionName:  actionModel.SelectOption.OptionName.ValueStringPointer(),
+					OptionValue: actionModel.SelectOption.OptionValue.ValueStringPointer(),
+				}
 			}
 		}
 
@@ -1485,6 +1515,10 @@ func mapToAndroidJourneyCheckModel(check AndroidJourneyCheck) AndroidJourneyChec
 		checkModel.CheckGroupId = types.Int32Value(int32(check.CheckGroup.Id))
 	}
 
+	if check.ProxyHost != nil {
+		checkModel.ProxyHostId = types.Int32Value(int32(check.ProxyHost.Id))
+	}
+
 	checkModel.Apk = types.StringValue(check.Apk)
 	checkModel.ApkChecksum = types.StringValue(check.ApkChecksum)
 	checkModel.ScreenOrientation = types.StringValue(check.ScreenOrientation)
@@ -1496,7 +1530,7 @@ func mapToAndroidJourneyCheckModel(check AndroidJourneyCheck) AndroidJourneyChec
 			stepModel := AndroidJourneyCommonStepStepModel{}
 			stepModel.Id = types.Int64Value(step.Id)
 			stepModel.Sequence = types.Int32Value(int32(step.Sequence))
-			stepModel.CommonStepId = types.Int64Value(*step.CommonId)
+			stepModel.CommonStepId = types.Int64PointerValue(step.CommonId)
 
 			checkModel.CommonSteps = append(checkModel.CommonSteps, stepModel)
 			continue
@@ -1714,6 +1748,14 @@ func mapToAndroidJourneyCommonStepModel(step AndroidJourneyCommonStep) AndroidJo
 	return stepModel
 }
 
+func mapToAndroidJourneyCommonStepSummaryModel(step AndroidJourneyCommonStep) NamedItemSummaryModel {
+	return NamedItemSummaryModel{
+		Id:          types.Int32Value(int32(step.Id)),
+		Name:        types.StringValue(step.Name),
+		Description: types.StringPointerValue(step.Description),
+	}
+}
+
 func mapToWebJourneyCheckModel(check WebJourneyCheck) WebJourneyCheckModel {
 	checkModel := WebJourneyCheckModel{}
 	checkModel.Id = types.Int64Value(check.Id)
@@ -2186,3 +2228,228 @@ func mapToWebJourneyCommonStepModel(step WebJourneyCommonStep) WebJourneyCommonS
 
 	return stepModel
 }
+
+func mapToWebJourneyCommonStepSummaryModel(step WebJourneyCommonStep) NamedItemSummaryModel {
+	return NamedItemSummaryModel{
+		Id:          types.Int32Value(int32(step.Id)),
+		Name:        types.StringValue(step.Name),
+		Description: types.StringValue(step.Description),
+	}
+}
+
+func mapToCheckDataSourceModel(check Check) CheckDataSourceModel {
+	checkModel := CheckDataSourceModel{}
+	checkModel.Id = types.Int64Value(check.Id)
+	checkModel.Name = types.StringValue(check.Name)
+	checkModel.CheckType = types.StringValue(check.CheckType)
+	checkModel.Description = types.StringValue(check.Description)
+	checkModel.Enabled = types.BoolValue(check.Enabled)
+	checkModel.MaintenanceOverride = types.BoolValue(check.MaintenanceOverride)
+	checkModel.CheckFrequency = types.Int32Value(check.CheckFrequency)
+	checkModel.TriggerCount = types.Int32Value(check.TriggerCount)
+	checkModel.ResultRetentionDays = types.Int32Value(check.ResultRetentionDays)
+	checkModel.CheckHostId = types.Int32Null()
+	checkModel.HostGroupId = types.Int32Null()
+	checkModel.CheckGroupId = types.Int32Null()
+	checkModel.ProxyHostId = types.Int32Null()
+
+	if check.CheckHost != nil {
+		checkModel.CheckHostId = types.Int32Value(int32(check.CheckHost.Id))
+	}
+
+	if check.HostGroup != nil {
+		checkModel.HostGroupId = types.Int32Value(int32(check.HostGroup.Id))
+	}
+
+	if check.CheckGroup != nil {
+		checkModel.CheckGroupId = types.Int32Value(int32(check.CheckGroup.Id))
+	}
+
+	if check.ProxyHost != nil {
+		checkModel.ProxyHostId = types.Int32Value(int32(check.ProxyHost.Id))
+	}
+
+	return checkModel
+}
+
+func mapToCheckSummaryModel(check Check) CheckSummaryModel {
+	checkModel := mapToCheckDataSourceModel(check)
+
+	summary := CheckSummaryModel{
+		Id:               checkModel.Id,
+		Name:             checkModel.Name,
+		Description:      checkModel.Description,
+		CheckType:        checkModel.CheckType,
+		Enabled:          checkModel.Enabled,
+		CheckFrequency:   checkModel.CheckFrequency,
+		CheckGroupId:     checkModel.CheckGroupId,
+		DashboardGroupId: types.Int32Null(),
+		CheckHostId:      checkModel.CheckHostId,
+		HostGroupId:      checkModel.HostGroupId,
+		ProxyHostId:      checkModel.ProxyHostId,
+	}
+
+	// The dashboard group of a check is that of its check group.
+	if check.CheckGroup != nil {
+		summary.DashboardGroupId = types.Int32Value(int32(check.CheckGroup.DashboardGroup.Id))
+	}
+
+	return summary
+}
+
+func mapToUrlCheckDataModel(checkModel UrlCheckModel) *UrlCheckDataModel {
+	return &UrlCheckDataModel{
+		URL:                  checkModel.URL,
+		RequestMethod:        checkModel.RequestMethod,
+		ExpectedResponseCode: checkModel.ExpectedResponseCode,
+		WarningResponseTime:  checkModel.WarningRepsonseTime,
+		AlertResponseTime:    checkModel.AlertResponseTime,
+		Timeout:              checkModel.Timeout,
+		AllowRedirects:       checkModel.AllowRedirects,
+		RequestBody:          checkModel.RequestBody,
+		RequestHeader:        checkModel.RequestHeader,
+		ResponseBodyCheck:    checkModel.ResponseBodyCheck,
+	}
+}
+
+func mapToDnsCheckDataModel(checkModel DnsCheckModel) *DnsCheckDataModel {
+	return &DnsCheckDataModel{
+		Hostname:          checkModel.Hostname,
+		ExpectedAddresses: checkModel.ExpectedAddresses,
+	}
+}
+
+func mapToPingCheckDataModel(checkModel PingCheckModel) *PingCheckDataModel {
+	return &PingCheckDataModel{
+		Hostname:            checkModel.Hostname,
+		TimeoutTime:         checkModel.TimeoutTime,
+		WarningResponseTime: checkModel.WarningResponseTime,
+	}
+}
+
+func mapToSocketCheckDataModel(checkModel SocketCheckModel) *SocketCheckDataModel {
+	return &SocketCheckDataModel{
+		Hostname: checkModel.Hostname,
+		Port:     checkModel.Port,
+	}
+}
+
+func mapToCertificateCheckDataModel(checkModel CertificateCheckModel) *CertificateCheckDataModel {
+	return &CertificateCheckDataModel{
+		Url:                  checkModel.Url,
+		WarningDaysRemaining: checkModel.WarningDaysRemaining,
+		AlertDaysRemaining:   checkModel.AlertDaysRemaining,
+		CheckDateOnly:        checkModel.CheckDateOnly,
+		CheckFullChain:       checkModel.CheckFullChain,
+	}
+}
+
+func mapToWebJourneyCheckDataModel(checkModel WebJourneyCheckModel) *WebJourneyCheckDataModel {
+	dataModel := WebJourneyCheckDataModel{
+		StartUrl:       checkModel.StartUrl,
+		WindowHeight:   checkModel.WindowHeight,
+		WindowWidth:    checkModel.WindowWidth,
+		MonitorDomains: checkModel.MonitorDomains,
+		Steps:          []JourneyStepDataModel{},
+	}
+
+	for _, step := range checkModel.Steps {
+		stepModel := JourneyStepDataModel{
+			Id:           step.Id,
+			Sequence:     step.Sequence,
+			Type:         step.Type,
+			Name:         step.Name,
+			CommonStepId: step.CommonId,
+			PageChecks:   []JourneyPageCheckDataModel{},
+			Actions:      []JourneyActionDataModel{},
+		}
+
+		for _, pageCheck := range step.PageChecks {
+			stepModel.PageChecks = append(stepModel.PageChecks, JourneyPageCheckDataModel{
+				Id:          pageCheck.Id,
+				Type:        pageCheck.Type,
+				Description: pageCheck.Description,
+				WarningOnly: pageCheck.WarningOnly,
+			})
+		}
+
+		for _, action := range step.Actions {
+			stepModel.Actions = append(stepModel.Actions, JourneyActionDataModel{
+				Id:             action.Id,
+				Sequence:       action.Sequence,
+				Type:           action.Type,
+				Description:    action.Description,
+				AlwaysRequired: action.AlwaysRequired,
+				WaitTime:       action.WaitTime,
+			})
+		}
+
+		dataModel.Steps = append(dataModel.Steps, stepModel)
+	}
+
+	return &dataModel
+}
+
+func mapToAndroidJourneyCheckDataModel(checkModel AndroidJourneyCheckModel) *AndroidJourneyCheckDataModel {
+	dataModel := AndroidJourneyCheckDataModel{
+		ApkChecksum:          checkModel.ApkChecksum,
+		ScreenOrientation:    checkModel.ScreenOrientation,
+		OverridePackageName:  checkModel.OverridePackageName,
+		OverrideMainActivity: checkModel.OverrideMainActivity,
+		Steps:                []JourneyStepDataModel{},
+	}
+
+	for _, step := range checkModel.CommonSteps {
+		dataModel.Steps = append(dataModel.Steps, JourneyStepDataModel{
+			Id:           step.Id,
+			Sequence:     step.Sequence,
+			Type:         types.StringValue("COMMON"),
+			Name:         types.StringNull(),
+			CommonStepId: step.CommonStepId,
+			PageChecks:   []JourneyPageCheckDataModel{},
+			Actions:      []JourneyActionDataModel{},
+		})
+	}
+
+	for _, step := range checkModel.CustomSteps {
+		stepModel := JourneyStepDataModel{
+			Id:           step.Id,
+			Sequence:     step.Sequence,
+			Type:         types.StringValue("CUSTOM"),
+			Name:         step.Name,
+			CommonStepId: types.Int64Null(),
+			PageChecks:   []JourneyPageCheckDataModel{},
+			Actions:      []JourneyActionDataModel{},
+		}
+
+		for _, stepCheck := range step.StepChecks {
+			stepModel.PageChecks = append(stepModel.PageChecks, JourneyPageCheckDataModel{
+				Id:          stepCheck.Id,
+				Type:        stepCheck.Type,
+				Description: stepCheck.Description,
+				WarningOnly: stepCheck.WarningOnly,
+			})
+		}
+
+		for _, interaction := range step.StepInteractions {
+			stepModel.Actions = append(stepModel.Actions, JourneyActionDataModel{
+				Id:             interaction.Id,
+				Sequence:       interaction.Sequence,
+				Type:           interaction.Type,
+				Description:    interaction.Description,
+				AlwaysRequired: interaction.AlwaysRequired,
+				WaitTime:       interaction.WaitTime,
+			})
+		}
+
+		dataModel.Steps = append(dataModel.Steps, stepModel)
+	}
+
+	// Common and custom steps are held separately by the resource, so put them back
+	// into the order they run in.
+	sort.SliceStable(dataModel.Steps, func(i, j int) bool {
+		return dataModel.Steps[i].Sequence.ValueInt32() < dataModel.Steps[j].Sequence.ValueInt32()
+	})
+
+	return &dataModel
+}
@@ -0,0 +1,798 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// modelComparer compares the framework's values with their Equal methods, as their
+// fields are unexported.
+var modelComparer = cmp.Options{
+	cmp.Comparer(func(a, b types.String) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Int32) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Int64) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Bool) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b timeouts.Value) bool { return a.Equal(b) }),
+}
+
+// referenceFields hold the items a check refers to. The provider only sends their
+// ids, while the API responds with the whole item.
+var referenceFields = []string{"checkHost", "hostGroup", "checkGroup", "proxyHost"}
+
+// testRoundTrip checks a payload captured from the API, in testdata, maps to model,
+// and that model maps back to what was captured. Fields the API responds with that
+// the provider doesn't send are left out of the comparison, as are the details of
+// the items a check refers to.
+func testRoundTrip[M, A any](t *testing.T, golden string, model M, toAPI func(M) A, toModel func(A) M) {
+	t.Helper()
+
+	path := filepath.Join("testdata", golden+".json")
+
+	captured, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var api A
+	if err := json.Unmarshal(captured, &api); err != nil {
+		t.Fatalf("decoding %s: %s", path, err)
+	}
+
+	if diff := cmp.Diff(model, toModel(api), modelComparer); diff != "" {
+		t.Errorf("model mapped from %s differs (-want +got):\n%s", path, diff)
+	}
+
+	sent, err := json.Marshal(toAPI(model))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sentValue, capturedValue any
+	if err := json.Unmarshal(sent, &sentValue); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal(captured, &capturedValue); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, difference := range comparePayloads("", sentValue, capturedValue) {
+		t.Errorf("payload sent differs from %s: %s", path, difference)
+	}
+}
+
+// comparePayloads describes where a payload sent to the API differs from one captured
+// from it, at the given path.
+func comparePayloads(at string, sent any, captured any) []string {
+	switch sent := sent.(type) {
+	case map[string]any:
+		capturedObject, ok := captured.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s is an object, but %v was captured", at, captured)}
+		}
+
+		differences := []string{}
+
+		for field, value := range sent {
+			capturedValue, ok := capturedObject[field]
+			if !ok {
+				differences = append(differences, fmt.Sprintf("%s.%s is sent, but wasn't captured", at, field))
+				continue
+			}
+
+			if reference, ok := value.(map[string]any); ok && slices.Contains(referenceFields, field) {
+				value = map[string]any{"id": reference["id"]}
+
+				if capturedReference, ok := capturedValue.(map[string]any); ok {
+					capturedValue = map[string]any{"id": capturedReference["id"]}
+				}
+			}
+
+			differences = append(differences, comparePayloads(at+"."+field, value, capturedValue)...)
+		}
+
+		return differences
+	case []any:
+		capturedList, ok := captured.([]any)
+		if !ok || len(capturedList) != len(sent) {
+			return []string{fmt.Sprintf("%s has %d items, but %v was captured", at, len(sent), captured)}
+		}
+
+		differences := []string{}
+
+		for i := range sent {
+			differences = append(differences, comparePayloads(fmt.Sprintf("%s[%d]", at, i), sent[i], capturedList[i])...)
+		}
+
+		return differences
+	default:
+		if sent != captured {
+			return []string{fmt.Sprintf("%s is %v, but %v was captured", at, sent, captured)}
+		}
+
+		return nil
+	}
+}
+
+// fuzzMapping checks a payload from the API maps to a model without panicking and
+// that, once the first round trip has filled in what the API left out, further round
+// trips leave the model unchanged.
+func fuzzMapping[M, A any](f *testing.F, goldens []string, toAPI func(M) A, toModel func(A) M) {
+	for _, golden := range goldens {
+		payload, err := os.ReadFile(filepath.Join("testdata", golden+".json"))
+		if err != nil {
+			f.Fatal(err)
+		}
+
+		f.Add(payload)
+	}
+
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		var api A
+		if err := json.Unmarshal(payload, &api); err != nil {
+			return
+		}
+
+		model := toModel(toAPI(toModel(api)))
+
+		if diff := cmp.Diff(model, toModel(toAPI(model)), modelComparer); diff != "" {
+			t.Errorf("model changed by a round trip (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func testCheckCommonModel(id int64, name string) CheckCommonModel {
+	return CheckCommonModel{
+		Id:                  types.Int64Value(id),
+		Name:                types.StringValue(name),
+		Description:         types.StringValue(name + " description."),
+		Enabled:             types.BoolValue(true),
+		MaintenanceOverride: types.BoolValue(false),
+		CheckFrequency:      types.Int32Value(60),
+		TriggerCount:        types.Int32Value(2),
+		ResultRetentionDays: types.Int32Value(30),
+		CheckHostId:         types.Int32Value(3),
+		CheckGroupId:        types.Int32Value(4),
+	}
+}
+
+func testUrlCheckModel() UrlCheckModel {
+	checkModel := UrlCheckModel{CheckCommonModel: testCheckCommonModel(11, "Home Page")}
+	checkModel.URL = types.StringValue("https://www.mycompany.com/")
+	checkModel.RequestMethod = types.StringValue("POST")
+	checkModel.ExpectedResponseCode = types.Int32Value(200)
+	checkModel.WarningRepsonseTime = types.Int32Value(5000)
+	checkModel.AlertResponseTime = types.Int32Value(10000)
+	checkModel.Timeout = types.Int32Value(20000)
+	checkModel.AllowRedirects = types.BoolValue(true)
+	checkModel.RequestBody = types.StringValue(`{"search":"widgets"}`)
+
+	checkModel.RequestHeader = append(checkModel.RequestHeader, struct {
+		Name  types.String `tfsdk:"name"`
+		Value types.String `tfsdk:"value"`
+	}{Name: types.StringValue("Authorization"), Value: types.StringValue("REDACTED")})
+
+	checkModel.ResponseBodyCheck = append(checkModel.ResponseBodyCheck, struct {
+		String     types.String `tfsdk:"string"`
+		Comparator types.String `tfsdk:"comparator"`
+	}{String: types.StringValue("widgets"), Comparator: types.StringValue("CONTAINS")})
+
+	return checkModel
+}
+
+func testUrlCheckModelOnHostGroup() UrlCheckModel {
+	checkModel := UrlCheckModel{CheckCommonModel: testCheckCommonModel(12, "Status Page")}
+	checkModel.CheckHostId = types.Int32Null()
+	checkModel.HostGroupId = types.Int32Value(5)
+	checkModel.ProxyHostId = types.Int32Value(6)
+	checkModel.URL = types.StringValue("https://status.mycompany.com/")
+	checkModel.RequestMethod = types.StringValue("GET")
+	checkModel.ExpectedResponseCode = types.Int32Value(200)
+	checkModel.WarningRepsonseTime = types.Int32Value(5000)
+	checkModel.AlertResponseTime = types.Int32Value(10000)
+	checkModel.Timeout = types.Int32Value(20000)
+	checkModel.AllowRedirects = types.BoolValue(false)
+	checkModel.RequestBody = types.StringValue("")
+
+	return checkModel
+}
+
+func testDnsCheckModel() DnsCheckModel {
+	checkModel := DnsCheckModel{CheckCommonModel: testCheckCommonModel(13, "DNS")}
+	checkModel.Hostname = types.StringValue("www.mycompany.com")
+	checkModel.ExpectedAddresses = []types.String{types.StringValue("10.0.0.1"), types.StringValue("10.0.0.2")}
+
+	return checkModel
+}
+
+func testPingCheckModel() PingCheckModel {
+	checkModel := PingCheckModel{CheckCommonModel: testCheckCommonModel(14, "Ping")}
+	checkModel.Hostname = types.StringValue("router.mycompany.com")
+	checkModel.TimeoutTime = types.Int32Value(2000)
+	checkModel.WarningResponseTime = types.Int32Value(500)
+
+	return checkModel
+}
+
+func testSocketCheckModel() SocketCheckModel {
+	checkModel := SocketCheckModel{CheckCommonModel: testCheckCommonModel(15, "Database Port")}
+	checkModel.Hostname = types.StringValue("db.mycompany.com")
+	checkModel.Port = types.Int32Value(5432)
+
+	return checkModel
+}
+
+func testCertificateCheckModel() CertificateCheckModel {
+	checkModel := CertificateCheckModel{CheckCommonModel: testCheckCommonModel(16, "Certificate")}
+	checkModel.Url = types.StringValue("https://www.mycompany.com/")
+	checkModel.AlertDaysRemaining = types.Int32Value(7)
+	checkModel.WarningDaysRemaining = types.Int32Value(30)
+	checkModel.CheckDateOnly = types.BoolValue(false)
+	checkModel.CheckFullChain = types.BoolValue(true)
+
+	return checkModel
+}
+
+func testWebJourneyPageChecks() []WebJourneyPageCheckModel {
+	return []WebJourneyPageCheckModel{
+		{
+			Id:          types.Int64Value(101),
+			Description: types.StringValue("Welcome shown"),
+			WarningOnly: types.BoolValue(false),
+			Type:        types.StringValue("CHECK_FOR_TEXT"),
+			PageCheckForText: &PageCheckForTextModel{
+				Id:          types.Int64Value(102),
+				TextToFind:  types.StringValue("Welcome"),
+				ElementType: types.StringValue("h1"),
+				State:       types.StringValue("PRESENT"),
+			},
+		},
+		{
+			Id:          types.Int64Value(103),
+			Description: types.StringValue("Log in button shown"),
+			WarningOnly: types.BoolValue(true),
+			Type:        types.StringValue("CHECK_ELEMENT_ON_PAGE"),
+			PageCheckForElement: &PageCheckForElementModel{
+				Id:             types.Int64Value(104),
+				ElementId:      types.StringValue("login"),
+				ElementName:    types.StringNull(),
+				State:          types.StringValue("PRESENT"),
+				AttributeName:  types.StringValue("type"),
+				AttributeValue: types.StringValue("submit"),
+				ElementConent:  types.StringNull(),
+			},
+		},
+		{
+			Id:          types.Int64Value(105),
+			Description: types.StringValue("On the home page"),
+			WarningOnly: types.BoolValue(false),
+			Type:        types.StringValue("CHECK_CURRENT_URL"),
+			PageCheckCurrentURL: &PageCheckCurrentURLModel{
+				Id:         types.Int64Value(106),
+				Url:        types.StringValue("https://www.mycompany.com/home"),
+				Comparison: types.StringValue("EQUALS"),
+			},
+		},
+		{
+			Id:          types.Int64Value(107),
+			Description: types.StringValue("API responds"),
+			WarningOnly: types.BoolValue(false),
+			Type:        types.StringValue("CHECK_URL_RESPONSE"),
+			PageCheckURLResponse: &PageCheckURLResponseModel{
+				Id:                     types.Int64Value(108),
+				Url:                    types.StringValue("/api/"),
+				Comparison:             types.StringValue("CONTAINS"),
+				WarningResponseTime:    types.Int32Value(1000),
+				AlertResponseTime:      types.Int32Value(3000),
+				ResponseCode:           types.Int32Value(0),
+				AnyInfoResponse:        types.BoolValue(false),
+				AnySuccessReponse:      types.BoolValue(true),
+				AnyRedirectResponse:    types.BoolValue(false),
+				AnyClientErrorResponse: types.BoolValue(false),
+				AnyServerErrorResponse: types.BoolValue(false),
+			},
+		},
+		{
+			Id:          types.Int64Value(109),
+			Description: types.StringValue("No script errors"),
+			WarningOnly: types.BoolValue(true),
+			Type:        types.StringValue("CHECK_CONSOLE_LOG"),
+			PageCheckConsoleLog: &PageCheckConsoleLogModel{
+				Id:         types.Int64Value(110),
+				LogLevel:   types.StringValue("SEVERE"),
+				Message:    types.StringValue("Uncaught"),
+				Comparison: types.StringValue("CONTAINS"),
+			},
+		},
+	}
+}
+
+func testWebJourneyActions() []WebJourneyActionModel {
+	action := func(id int64, sequence int32, actionType string) WebJourneyActionModel {
+		return WebJourneyActionModel{
+			Id:             types.Int64Value(id),
+			Sequence:       types.Int32Value(sequence),
+			Description:    types.StringValue(actionType + " action"),
+			AlwaysRequired: types.BoolValue(sequence == 1),
+			Type:           types.StringValue(actionType),
+		}
+	}
+
+	click := action(201, 1, "CLICK")
+	click.Click = &WebJourneyActionClickModel{
+		ElementType: types.StringValue("button"),
+		SearchText:  types.StringValue("Accept"),
+		Xpath:       types.StringNull(),
+	}
+
+	doubleClick := action(202, 2, "DOUBLE_CLICK")
+	doubleClick.Click = &WebJourneyActionClickModel{
+		ElementType: types.StringNull(),
+		SearchText:  types.StringNull(),
+		Xpath:       types.StringValue("//div[@id='menu']"),
+	}
+
+	rightClick := action(203, 3, "RIGHT_CLICK")
+	rightClick.Click = &WebJourneyActionClickModel{
+		ElementType: types.StringValue("a"),
+		SearchText:  types.StringValue("Help"),
+		Xpath:       types.StringNull(),
+	}
+
+	textInput := action(204, 4, "TEXT_INPUT")
+	textInput.TextInput = &WebJourneyActionTextInputModel{
+		InputText:   types.StringValue("monitor"),
+		ElementId:   types.StringValue("username"),
+		ElementName: types.StringNull(),
+		Xpath:       types.StringNull(),
+	}
+
+	passwordInput := action(205, 5, "PASSWORD_INPUT")
+	passwordInput.PasswordInput = &WebJourneyActionPasswordInputModel{
+		InputPassword: types.StringValue("REDACTED"),
+		ElementId:     types.StringNull(),
+		ElementName:   types.StringValue("password"),
+		Xpath:         types.StringNull(),
+	}
+
+	windowByOrder := action(206, 6, "CHANGE_WINDOW_BY_ORDER")
+	windowByOrder.WindowId = types.Int32Value(1)
+
+	windowByTitle := action(207, 7, "CHANGE_WINDOW_BY_TITLE")
+	windowByTitle.WindowTitle = types.StringValue("Help")
+
+	navigate := action(208, 8, "NAVIGATE_URL")
+	navigate.NavigateUrl = types.StringValue("https://www.mycompany.com/account")
+
+	wait := action(209, 9, "WAIT")
+	wait.WaitTime = types.Int32Value(2000)
+
+	iframeByOrder := action(210, 10, "CHANGE_IFRAME_BY_ORDER")
+	iframeByOrder.IframeId = types.Int32Value(0)
+
+	iframeByXpath := action(211, 11, "CHANGE_IFRAME_BY_XPATH")
+	iframeByXpath.IframeXpath = types.StringValue("//iframe[@name='payment']")
+
+	scroll := action(212, 12, "SCROLL_TO_ELEMENT")
+	scroll.ScrollToElement = &WebJourneyActionScrollToElementModel{
+		ElementType: types.StringValue("footer"),
+		SearchText:  types.StringNull(),
+		Xpath:       types.StringNull(),
+	}
+
+	selectOption := action(213, 13, "SELECT_OPTION")
+	selectOption.SelectOption = &WebJourneyActionSelectOptionModel{
+		ElementId:   types.StringValue("country"),
+		OptionIndex: types.Int32Null(),
+		OptionName:  types.StringValue("United Kingdom"),
+		OptionValue: types.StringNull(),
+		Xpath:       types.StringNull(),
+	}
+
+	return []WebJourneyActionModel{
+		click, doubleClick, rightClick, textInput, passwordInput, windowByOrder, windowByTitle,
+		navigate, wait, iframeByOrder, iframeByXpath, scroll, selectOption,
+	}
+}
+
+func testWebJourneyConsoleMessageSuppressions() []ConsoleMessageSuppressionModel {
+	return []ConsoleMessageSuppressionModel{
+		{
+			Id:          types.Int64Value(301),
+			Description: types.StringValue("Ignore analytics warnings"),
+			LogLevel:    types.StringValue("WARNING"),
+			Message:     types.StringValue("analytics"),
+			Comparison:  types.StringValue("CONTAINS"),
+		},
+	}
+}
+
+func testWebJourneyNetworkSuppressions() []NetworkSuppressionModel {
+	return []NetworkSuppressionModel{
+		{
+			Id:             types.Int64Value(302),
+			Description:    types.StringValue("Ignore missing favicon"),
+			Url:            types.StringValue("/favicon.ico"),
+			Comparison:     types.StringValue("ENDS_WITH"),
+			AnyClientError: types.BoolValue(false),
+			AnyServerError: types.BoolValue(false),
+			ResponseCode:   types.Int32Value(404),
+		},
+		{
+			Id:             types.Int64Value(303),
+			Description:    types.StringValue("Ignore tracking errors"),
+			Url:            types.StringValue("tracking"),
+			Comparison:     types.StringValue("CONTAINS"),
+			AnyClientError: types.BoolValue(true),
+			AnyServerError: types.BoolValue(true),
+			ResponseCode:   types.Int32Null(),
+		},
+	}
+}
+
+func testWebJourneyCheckModel() WebJourneyCheckModel {
+	checkModel := WebJourneyCheckModel{CheckCommonModel: testCheckCommonModel(17, "Log In Journey")}
+	checkModel.ProxyHostId = types.Int32Value(6)
+	checkModel.StartUrl = types.StringValue("https://www.mycompany.com/")
+	checkModel.WindowHeight = types.Int32Value(1080)
+	checkModel.WindowWidth = types.Int32Value(1920)
+
+	checkModel.MonitorDomains = append(checkModel.MonitorDomains, struct {
+		Domain            types.String `tfsdk:"domain"`
+		IncludeSubDomains types.Bool   `tfsdk:"include_sub_domains"`
+	}{Domain: types.StringValue("mycompany.com"), IncludeSubDomains: types.BoolValue(true)})
+
+	checkModel.Steps = []WebJourneyStepModel{
+		{
+			Id:                  types.Int64Value(401),
+			Sequence:            types.Int32Value(0),
+			Type:                types.StringValue("COMMON"),
+			Name:                types.StringValue(""),
+			CommonId:            types.Int64Value(7),
+			WaitTime:            types.Int32Value(0),
+			WarningPageLoadTime: types.Int32Value(0),
+			AlertPageLoadTime:   types.Int32Value(0),
+		},
+		{
+			Id:                         types.Int64Value(402),
+			Sequence:                   types.Int32Value(1),
+			Type:                       types.StringValue("CUSTOM"),
+			Name:                       types.StringValue("Log in"),
+			CommonId:                   types.Int64Null(),
+			WaitTime:                   types.Int32Value(1000),
+			WarningPageLoadTime:        types.Int32Value(3000),
+			AlertPageLoadTime:          types.Int32Value(6000),
+			PageChecks:                 testWebJourneyPageChecks(),
+			ConsoleMessageSuppressions: testWebJourneyConsoleMessageSuppressions(),
+			NetworkSuppressions:        testWebJourneyNetworkSuppressions(),
+			Actions:                    testWebJourneyActions(),
+		},
+	}
+
+	return checkModel
+}
+
+func testWebJourneyCommonStepModel() WebJourneyCommonStepModel {
+	return WebJourneyCommonStepModel{
+		Id:                         types.Int64Value(7),
+		Name:                       types.StringValue("Accept Cookies"),
+		Description:                types.StringValue("Accepts the cookie banner."),
+		WaitTime:                   types.Int32Value(500),
+		WarningPageLoadTime:        types.Int32Value(3000),
+		AlertPageLoadTime:          types.Int32Value(6000),
+		PageChecks:                 testWebJourneyPageChecks(),
+		ConsoleMessageSuppressions: testWebJourneyConsoleMessageSuppressions(),
+		NetworkSuppressions:        testWebJourneyNetworkSuppressions(),
+		Actions:                    testWebJourneyActions(),
+	}
+}
+
+func testAndroidStepChecks() []AndroidStepCheckModel {
+	return []AndroidStepCheckModel{
+		{
+			Id:          types.Int64Value(501),
+			Description: types.StringValue("Welcome shown"),
+			WarningOnly: types.BoolValue(false),
+			Type:        types.StringValue("CHECK_FOR_TEXT"),
+			CheckForText: &AndroidCheckForTextModel{
+				Id:         types.Int64Value(502),
+				TextToFind: types.StringValue("Welcome"),
+				State:      types.StringValue("PRESENT"),
+			},
+		},
+		{
+			Id:          types.Int64Value(503),
+			Description: types.StringValue("Log in enabled"),
+			WarningOnly: types.BoolValue(true),
+			Type:        types.StringValue("CHECK_FOR_ELEMENT"),
+			CheckForElement: &AndroidCheckForElementModel{
+				Id:             types.Int64Value(504),
+				ComponentId:    types.StringValue("com.mycompany.app:id/login"),
+				ComponentType:  types.StringValue("android.widget.Button"),
+				Xpath:          types.StringNull(),
+				State:          types.StringValue("PRESENT"),
+				AttributeName:  types.StringValue("enabled"),
+				AttributeValue: types.StringValue("true"),
+			},
+		},
+	}
+}
+
+func testAndroidStepInteractions() []AndroidStepInteractionModel {
+	interaction := func(id int64, sequence int32, action string) AndroidStepInteractionModel {
+		return AndroidStepInteractionModel{
+			Id:             types.Int64Value(id),
+			Sequence:       types.Int32Value(sequence),
+			Description:    types.StringValue(action + " interaction"),
+			AlwaysRequired: types.BoolValue(sequence == 1),
+			Type:           types.StringValue(action),
+		}
+	}
+
+	click := interaction(601, 1, "CLICK")
+	click.Click = &AndroidClickActionModel{
+		ComponentId: types.StringNull(),
+		Xpath:       types.StringNull(),
+		SearchText:  types.StringValue("Accept"),
+	}
+
+	textInput := interaction(602, 2, "TEXT_INPUT")
+	textInput.TextInput = &AndroidInputTextActionModel{
+		ComponentId: types.StringValue("com.mycompany.app:id/username"),
+		Xpath:       types.StringNull(),
+		InputText:   types.StringValue("monitor"),
+	}
+
+	passwordInput := interaction(603, 3, "PASSWORD_INPUT")
+	passwordInput.PasswordInput = &AndroidInputPasswordActionModel{
+		ComponentId:   types.StringValue("com.mycompany.app:id/password"),
+		Xpath:         types.StringNull(),
+		InputPassword: types.StringValue("REDACTED"),
+	}
+
+	rotate := interaction(604, 4, "ROTATE_DISPLAY")
+	rotate.RotateDisplay = &AndroidRotateDisplayActionModel{
+		Orientation: types.StringValue("LANDSCAPE"),
+	}
+
+	spinner := interaction(605, 5, "SELECT_SPINNER_OPTION")
+	spinner.SelectSpinnerOption = &AndroidSelectSpinnerOptionActionModel{
+		ComponentId:        types.StringValue("com.mycompany.app:id/country"),
+		Xpath:              types.StringNull(),
+		SearchText:         types.StringNull(),
+		OptionListPosition: types.Int32Value(2),
+		OptionListText:     types.StringNull(),
+	}
+
+	swipe := interaction(606, 6, "SWIPE")
+	swipe.Swipe = &AndroidSwipeActionModel{
+		ComponentId:           types.StringNull(),
+		Xpath:                 types.StringNull(),
+		StartSwipeCoordinates: types.StringValue("540,1600"),
+		SwipeDirection:        types.StringValue("UP"),
+		SwipeLength:           types.Int32Value(800),
+	}
+
+	wait := interaction(607, 7, "WAIT")
+	wait.WaitTime = types.Int32Value(1500)
+
+	return []AndroidStepInteractionModel{click, textInput, passwordInput, rotate, spinner, swipe, wait}
+}
+
+func testAndroidJourneyCheckModel() AndroidJourneyCheckModel {
+	checkModel := AndroidJourneyCheckModel{CheckCommonModel: testCheckCommonModel(18, "App Journey")}
+	checkModel.Apk = types.StringValue("UEsDBBQAAAAIAA==")
+	checkModel.ApkChecksum = types.StringValue("5d41402abc4b2a76b9719d911017c592")
+	checkModel.ScreenOrientation = types.StringValue("PORTRAIT")
+	checkModel.OverridePackageName = types.StringValue("com.mycompany.app")
+	checkModel.OverrideMainActivity = types.StringNull()
+
+	checkModel.CommonSteps = []AndroidJourneyCommonStepStepModel{
+		{
+			Id:           types.Int64Value(701),
+			Sequence:     types.Int32Value(0),
+			CommonStepId: types.Int64Value(8),
+		},
+	}
+
+	checkModel.CustomSteps = []AndroidJourneyCustomStepModel{
+		{
+			Id:               types.Int64Value(702),
+			Sequence:         types.Int32Value(1),
+			Name:             types.StringValue("Log in"),
+			WaitTime:         types.Int32Value(1000),
+			StepChecks:       testAndroidStepChecks(),
+			StepInteractions: testAndroidStepInteractions(),
+		},
+	}
+
+	return checkModel
+}
+
+func testAndroidJourneyCommonStepModel() AndroidJourneyCommonStepModel {
+	return AndroidJourneyCommonStepModel{
+		Id:               types.Int64Value(8),
+		Name:             types.StringValue("Accept Terms"),
+		Description:      types.StringValue("Accepts the terms on first launch."),
+		WaitTime:         types.Int32Value(500),
+		StepChecks:       testAndroidStepChecks(),
+		StepInteractions: testAndroidStepInteractions(),
+	}
+}
+
+func TestCheckMappingRoundTrip(t *testing.T) {
+	tests := []struct {
+		golden    string
+		roundTrip func(t *testing.T, golden string)
+	}{
+		{
+			golden: "url_check",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testUrlCheckModel(), mapToUrlCheck, mapToUrlCheckModel)
+			},
+		},
+		{
+			golden: "url_check_on_host_group",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testUrlCheckModelOnHostGroup(), mapToUrlCheck, mapToUrlCheckModel)
+			},
+		},
+		{
+			golden: "dns_check",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testDnsCheckModel(), mapToDnsCheck, mapToDnsCheckModel)
+			},
+		},
+		{
+			golden: "ping_check",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testPingCheckModel(), mapToPingCheck, mapToPingCheckModel)
+			},
+		},
+		{
+			golden: "socket_check",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testSocketCheckModel(), mapToSocketCheck, mapToSocketCheckModel)
+			},
+		},
+		{
+			golden: "certificate_check",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testCertificateCheckModel(), mapToCertificateCheck, mapToCertificateCheckModel)
+			},
+		},
+		{
+			golden: "web_journey_check",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testWebJourneyCheckModel(), mapToWebJourneyCheck, mapToWebJourneyCheckModel)
+			},
+		},
+		{
+			golden: "web_journey_common_step",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testWebJourneyCommonStepModel(), mapToWebJourneyCommonStep, mapToWebJourneyCommonStepModel)
+			},
+		},
+		{
+			golden: "android_journey_check",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testAndroidJourneyCheckModel(), mapToAndroidJourneyCheck, mapToAndroidJourneyCheckModel)
+			},
+		},
+		{
+			golden: "android_journey_common_step",
+			roundTrip: func(t *testing.T, golden string) {
+				testRoundTrip(t, golden, testAndroidJourneyCommonStepModel(), mapToAndroidJourneyCommonStep, mapToAndroidJourneyCommonStepModel)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.golden, func(t *testing.T) {
+			test.roundTrip(t, test.golden)
+		})
+	}
+}
+
+// TestUrlCheckEmptyLists checks a URL check without request headers or response
+// checks is mapped the same whether the API gives their lists as null or empty, and
+// that empty lists are sent for them rather than null.
+func TestUrlCheckEmptyLists(t *testing.T) {
+	captured, err := os.ReadFile(filepath.Join("testdata", "url_check_on_host_group.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, list := range []string{"null", "[]"} {
+		t.Run(list, func(t *testing.T) {
+			payload := map[string]json.RawMessage{}
+			if err := json.Unmarshal(captured, &payload); err != nil {
+				t.Fatal(err)
+			}
+
+			payload["requestHeaders"] = json.RawMessage(list)
+			payload["responseCheckStrings"] = json.RawMessage(list)
+
+			body, err := json.Marshal(payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var check UrlCheck
+			if err := json.Unmarshal(body, &check); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(testUrlCheckModelOnHostGroup(), mapToUrlCheckModel(check), modelComparer); diff != "" {
+				t.Errorf("model differs (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	withoutLists := testUrlCheckModelOnHostGroup()
+	withEmptyLists := testUrlCheckModelOnHostGroup()
+	withEmptyLists.RequestHeader = testUrlCheckModel().RequestHeader[:0]
+	withEmptyLists.ResponseBodyCheck = testUrlCheckModel().ResponseBodyCheck[:0]
+
+	for name, model := range map[string]UrlCheckModel{"nil": withoutLists, "empty": withEmptyLists} {
+		sent, err := json.Marshal(mapToUrlCheck(model))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		payload := map[string]json.RawMessage{}
+		if err := json.Unmarshal(sent, &payload); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, field := range []string{"requestHeaders", "responseCheckStrings"} {
+			if got := string(payload[field]); got != "[]" {
+				t.Errorf("%s lists: sent %s as %s, want []", name, field, got)
+			}
+		}
+	}
+}
+
+func FuzzUrlCheckMapping(f *testing.F) {
+	fuzzMapping(f, []string{"url_check", "url_check_on_host_group"}, mapToUrlCheck, mapToUrlCheckModel)
+}
+
+func FuzzDnsCheckMapping(f *testing.F) {
+	fuzzMapping(f, []string{"dns_check"}, mapToDnsCheck, mapToDnsCheckModel)
+}
+
+func FuzzPingCheckMapping(f *testing.F) {
+	fuzzMapping(f, []string{"ping_check"}, mapToPingCheck, mapToPingCheckModel)
+}
+
+func FuzzSocketCheckMapping(f *testing.F) {
+	fuzzMapping(f, []string{"socket_check"}, mapToSocketCheck, mapToSocketCheckModel)
+}
+
+func FuzzCertificateCheckMapping(f *testing.F) {
+	fuzzMapping(f, []string{"certificate_check"}, mapToCertificateCheck, mapToCertificateCheckModel)
+}
+
+func FuzzWebJourneyCheckMapping(f *testing.F) {
+	fuzzMapping(f, []string{"web_journey_check"}, mapToWebJourneyCheck, mapToWebJourneyCheckModel)
+}
+
+func FuzzWebJourneyCommonStepMapping(f *testing.F) {
+	fuzzMapping(f, []string{"web_journey_common_step"}, mapToWebJourneyCommonStep, mapToWebJourneyCommonStepModel)
+}
+
+func FuzzAndroidJourneyCheckMapping(f *testing.F) {
+	fuzzMapping(f, []string{"android_journey_check"}, mapToAndroidJourneyCheck, mapToAndroidJourneyCheckModel)
+}
+
+func FuzzAndroidJourneyCommonStepMapping(f *testing.F) {
+	fuzzMapping(f, []string{"android_journey_common_step"}, mapToAndroidJourneyCommonStep, mapToAndroidJourneyCommonStepModel)
+}
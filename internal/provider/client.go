@@ -7,1804 +7,709 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
-)
-
-type EndPointMonitorClient struct {
-	HTTPClient *http.Client
-	HostURL    string
-	ApiKey     string
-}
-
-func NewEPMClient(hostUrl string, apiKey *string) (*EndPointMonitorClient, error) {
-	c := EndPointMonitorClient{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
-		HostURL:    hostUrl,
-		ApiKey:     *apiKey,
-	}
-
-	return &c, nil
-}
-
-func (c *EndPointMonitorClient) doRequest(req *http.Request) ([]byte, error) {
-	req.Header.Set("x-epm-auth", c.ApiKey)
-	req.Header.Set("content-type", "application/json")
-	req.Header.Set("accept", "application/json")
-
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
-	}
-
-	if res.StatusCode == http.StatusNotFound {
-		return nil, err
-	}
-
-	return body, err
-}
-
-func (c *EndPointMonitorClient) GetCheckGroup(id int32) (*CheckGroupModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checkGroups/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	checkGroup := CheckGroup{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &checkGroup)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
-
-	checkGroupModel := mapToCheckGroupModel(checkGroup)
-
-	return &checkGroupModel, nil
-}
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-func (c *EndPointMonitorClient) GetCheckHost(id int32) (*CheckHostModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/hosts/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	checkHost := CheckHost{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &checkHost)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+	"terraform-provider-endpointmonitor/internal/cassette"
+)
 
-	checkHostModel := mapToCheckHostModel(checkHost)
+const (
+	defaultMaxRetries     = 3
+	defaultRetryMaxWait   = 30 * time.Second
+	defaultAttemptTimeout = 30 * time.Second
+
+	// Operations are bounded by the timeouts block of each resource rather than a
+	// timeout on the shared HTTP client, so a slow operation can't affect others.
+	defaultOperationTimeout = 5 * time.Minute
+	defaultApkUploadTimeout = 15 * time.Minute
+
+	// Each attempt at a request that can be retried also has a deadline of its own,
+	// within that of the operation, so a hung connection is given up on and retried
+	// rather than using the whole operation timeout. Each attempt is given an equal
+	// share of the time the operation has left, but never less than these. Sending an
+	// APK can take far longer than anything else, so attempts that do are given longer.
+	apkUploadAttemptTimeout = 5 * time.Minute
+)
 
-	return &checkHostModel, nil
-}
+type EndPointMonitorClient struct {
+	HTTPClient   *http.Client
+	HostURL      string
+	ApiKey       string
+	MaxRetries   int
+	RetryMaxWait time.Duration
 
-func (c *EndPointMonitorClient) GetDashboardGroup(id int32) (*DashboardGroupModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dashboardGroups/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
+	// ServerInfo is what the server reported about itself during the health check,
+	// and is nil if the check was skipped or the server didn't report anything.
+	ServerInfo *ServerInfo
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+	// CheckDefaults are used for common check attributes a check doesn't set.
+	CheckDefaults CheckDefaults
 
-	dashboardGroup := DashboardGroup{}
+	// ReadOnly stops the client sending any request that would change EPM.
+	ReadOnly bool
 
-	if body != nil {
-		err = json.Unmarshal(body, &dashboardGroup)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+	// Naming is applied to the names and descriptions of the items the client sends,
+	// and removed from those it reads.
+	Naming Naming
 
-	dashboardGroupModel := mapToDashboardGroupModel(dashboardGroup)
+	// warnedCapabilities records the features already warned about as being of
+	// unknown support, so each is only warned about once per run.
+	warnedCapabilities sync.Map
 
-	return &dashboardGroupModel, nil
+	auth    authenticator
+	limiter *requestLimiter
+	cache   *responseCache
+	// recorder is recording the client's traffic to a cassette, if one was given.
+	recorder *cassette.Recorder
 }
 
-func (c *EndPointMonitorClient) GetCertificateCheck(id int64) (*CertificateCheckModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	check := CertificateCheck{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &check)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
-
-	checkModel := mapToCertificateCheckModel(check)
-
-	return &checkModel, nil
+// EndPointMonitorClientConfig holds the settings used to build an EndPointMonitorClient.
+type EndPointMonitorClientConfig struct {
+	HostURL               string
+	ApiKey                string
+	MaxRetries            int
+	RetryMaxWait          time.Duration
+	RequestsPerSecond     float64
+	MaxConcurrentRequests int
+	CACertFile            string
+	CACertPEM             string
+	ClientCert            string
+	ClientKey             string
+	InsecureSkipVerify    bool
+	ProxyURL              string
+	ProxyUsername         string
+	ProxyPassword         string
+	NoProxy               []string
+	CredentialHelper      []string
+	OAuth2                *OAuth2Config
+	CheckDefaults         CheckDefaults
+	Naming                Naming
+	ReadOnly              bool
+	RecordCassette        string
+	ReplayCassette        string
 }
 
-func (c *EndPointMonitorClient) GetDnsCheck(id int64) (*DnsCheckModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/%d", c.HostURL, id), nil)
+func NewEPMClient(config EndPointMonitorClientConfig) (*EndPointMonitorClient, error) {
+	tlsConfig, err := buildTLSConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	proxyFunc, err := buildProxyFunc(config)
 	if err != nil {
 		return nil, err
 	}
 
-	check := DnsCheck{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &check)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
-
-	checkModel := mapToDnsCheckModel(check)
-
-	return &checkModel, nil
-}
-
-func (c *EndPointMonitorClient) GetHostGroup(id int32) (*HostGroupModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/hostGroups/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = proxyFunc
 
-	body, err := c.doRequest(req)
+	roundTripper, err := buildCassetteTransport(config, transport)
 	if err != nil {
 		return nil, err
 	}
 
-	hostGroup := HostGroup{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &hostGroup)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
-
-	hostGroupModel := mapToHostGroupModel(hostGroup)
-
-	return &hostGroupModel, nil
-}
-
-func (c *EndPointMonitorClient) GetMaintenancePeriod(id int32) (*MaintenancePeriodModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/maintenancePeriods/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
+	httpClient := &http.Client{Transport: roundTripper}
 
-	body, err := c.doRequest(req)
+	auth, err := newAuthenticator(config, httpClient)
 	if err != nil {
 		return nil, err
 	}
 
-	maintenancePereiod := MaintenancePeriod{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &maintenancePereiod)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
+	c := EndPointMonitorClient{
+		HTTPClient:    httpClient,
+		HostURL:       config.HostURL,
+		ApiKey:        config.ApiKey,
+		MaxRetries:    config.MaxRetries,
+		RetryMaxWait:  config.RetryMaxWait,
+		CheckDefaults: config.CheckDefaults,
+		Naming:        config.Naming,
+		ReadOnly:      config.ReadOnly,
+		auth:          auth,
+		limiter:       newRequestLimiter(config.RequestsPerSecond, config.MaxConcurrentRequests),
+		cache:         newResponseCache(),
 	}
 
-	maintenancePereiodModel := mapToMaintenancePeriodModel(maintenancePereiod)
+	c.recorder, _ = roundTripper.(*cassette.Recorder)
 
-	return &maintenancePereiodModel, nil
+	return &c, nil
 }
 
-func (c *EndPointMonitorClient) GetPingCheck(id int64) (*PingCheckModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	check := PingCheck{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &check)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
+func (c *EndPointMonitorClient) doRequest(req *http.Request) ([]byte, error) {
+	if c.ReadOnly && req.Method != http.MethodGet {
+		return nil, fmt.Errorf("refusing %s %s: %w", req.Method, req.URL.Path, ErrReadOnly)
 	}
 
-	checkModel := mapToPingCheckModel(check)
-
-	return &checkModel, nil
-}
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("accept", "application/json")
 
-func (c *EndPointMonitorClient) GetProxyHost(id int32) (*ProxyHostModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/proxies/%d", c.HostURL, id), nil)
-	if err != nil {
+	if err := c.auth.authenticate(req); err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+	req = req.WithContext(c.newLogContext(req.Context()))
 
-	proxyHost := ProxyHost{}
+	for attempt := 0; ; attempt++ {
+		// The request context carries any cancellation or deadline from the calling
+		// Terraform operation, along with any tflog fields set against it.
+		logRequest(req, attempt)
 
-	if body != nil {
-		err = json.Unmarshal(body, &proxyHost)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+		start := time.Now()
+		res, body, err := c.send(req, attempt)
+		logResponse(req, res, body, err, time.Since(start))
 
-	proxyHostModel := mapToProxyHostModel(proxyHost)
+		retryable := isIdempotent(req) || notProcessed(res, err)
 
-	return &proxyHostModel, nil
-}
+		if retryable && attempt < c.MaxRetries && shouldRetry(res, err) {
+			wait := c.retryWait(attempt, res)
 
-func (c *EndPointMonitorClient) GetSocketCheck(id int64) (*SocketCheckModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
+			tflog.SubsystemWarn(req.Context(), logSubsystem, "Retrying EndPointMonitor API request after transient failure", map[string]any{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+				"error":   describeRetryCause(res, err),
+			})
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+			if err := sleepWithContext(req.Context(), wait); err != nil {
+				return nil, err
+			}
 
-	check := SocketCheck{}
+			if err := rewindBody(req); err != nil {
+				return nil, err
+			}
 
-	if body != nil {
-		err = json.Unmarshal(body, &check)
-		if err != nil {
-			return nil, err
+			continue
 		}
-	} else {
-		return nil, nil
-	}
-
-	checkModel := mapToSocketCheckModel(check)
-
-	return &checkModel, nil
-}
-
-func (c *EndPointMonitorClient) GetUrlCheck(id int64) (*UrlCheckModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
 
-	check := UrlCheck{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &check)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		return nil, nil
-	}
-
-	checkModel := mapToUrlCheckModel(check)
-
-	return &checkModel, nil
-}
 
-func (c *EndPointMonitorClient) GetAndroidJourneyCheck(id int64) (*AndroidJourneyCheckModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.HTTPClient.Timeout = time.Minute * 5
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	check := AndroidJourneyCheck{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &check)
-		if err != nil {
-			return nil, err
+		// A 404 is returned as an APIError matching ErrNotFound, so callers can tell
+		// an item that no longer exists apart from a request that failed.
+		if res.StatusCode != http.StatusOK {
+			return nil, newAPIError(req, res, body)
 		}
-	} else {
-		return nil, nil
-	}
-
-	checkModel := mapToAndroidJourneyCheckModel(check)
-
-	return &checkModel, nil
-}
-
-func (c *EndPointMonitorClient) GetWebJourneyCheck(id int64) (*WebJourneyCheckModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	check := WebJourneyCheck{}
 
-	if body != nil {
-		err = json.Unmarshal(body, &check)
-		if err != nil {
-			return nil, err
+		if len(body) == 0 {
+			return nil, fmt.Errorf("%s %s returned an empty response body", req.Method, req.URL.Path)
 		}
-	} else {
-		return nil, nil
-	}
-
-	checkModel := mapToWebJourneyCheckModel(check)
-
-	return &checkModel, nil
-}
-
-func (c *EndPointMonitorClient) GetCommonAndroidJourneyStep(id int64) (*AndroidJourneyCommonStepModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/commonSteps/android/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	step := AndroidJourneyCommonStep{}
 
-	if body != nil {
-		err = json.Unmarshal(body, &step)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
+		return body, nil
 	}
-
-	stepModel := mapToAndroidJourneyCommonStepModel(step)
-
-	return &stepModel, nil
 }
 
-func (c *EndPointMonitorClient) GetCommonWebJourneyStep(id int64) (*WebJourneyCommonStepModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/commonSteps/web/%d", c.HostURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	step := WebJourneyCommonStep{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &step)
-		if err != nil {
-			return nil, err
+// send performs a single attempt of the request, returning the response with its
+// body already read and closed. A request that can't be retried after timing out is
+// only bounded by the deadline of its operation, so it isn't given up on early.
+func (c *EndPointMonitorClient) send(req *http.Request, attempt int) (*http.Response, []byte, error) {
+	if c.limiter != nil {
+		if err := c.limiter.acquire(req.Context()); err != nil {
+			return nil, nil, err
 		}
-	} else {
-		return nil, nil
+		defer c.limiter.release()
 	}
 
-	stepModel := mapToWebJourneyCommonStepModel(step)
-
-	return &stepModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateCheckGroup(checkGroupModel CheckGroupModel, ctx context.Context) (*CheckGroupModel, error) {
-	checkGroup := mapToCheckGroup(checkGroupModel)
-
-	rb, err := json.Marshal(checkGroup)
-	if err != nil {
-		return nil, err
-	}
+	ctx := req.Context()
+	timeout := time.Duration(0)
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checkGroups/add", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
+	if isIdempotent(req) {
+		var cancel context.CancelFunc
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+		timeout = c.attemptTimeout(req.Context(), attempt)
+		ctx, cancel = context.WithTimeout(req.Context(), timeout)
+		defer cancel()
 	}
 
-	newCheckGroup := CheckGroup{}
-
-	err = json.Unmarshal(body, &newCheckGroup)
+	res, err := c.HTTPClient.Do(req.WithContext(ctx))
 	if err != nil {
-		return nil, err
+		return nil, nil, attemptError(ctx, req.Context(), timeout, err)
 	}
+	defer res.Body.Close()
 
-	newCheckGroupModel := mapToCheckGroupModel(newCheckGroup)
-
-	return &newCheckGroupModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateCheckHost(checkHostModel CheckHostModel, ctx context.Context) (*CheckHostModel, error) {
-	checkHost := mapToCheckHost(checkHostModel)
-
-	rb, err := json.Marshal(checkHost)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/hosts/add", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckHost := CheckHost{}
-
-	err = json.Unmarshal(body, &newCheckHost)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckHostModel := mapToCheckHostModel(newCheckHost)
-
-	return &newCheckHostModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateCertificateCheck(checkModel CertificateCheckModel, ctx context.Context) (*CertificateCheckModel, error) {
-	check := mapToCertificateCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/add/certificate", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := CertificateCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToCertificateCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateDashboardGroup(dashboardGroupModel DashboardGroupModel, ctx context.Context) (*DashboardGroupModel, error) {
-	dashboardGroup := mapToDashboardGroup(dashboardGroupModel)
-
-	rb, err := json.Marshal(dashboardGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/dashboardGroups/add", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newDashboardGroup := DashboardGroup{}
-
-	err = json.Unmarshal(body, &newDashboardGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	newDashboardGroupModel := mapToDashboardGroupModel(newDashboardGroup)
-
-	return &newDashboardGroupModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateDnsCheck(checkModel DnsCheckModel, ctx context.Context) (*DnsCheckModel, error) {
-	check := mapToDnsCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/add/dns", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := DnsCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToDnsCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateHostGroup(hostGroupModel HostGroupModel, ctx context.Context) (*HostGroupModel, error) {
-	hostGroup := mapToHostGroup(hostGroupModel)
-
-	rb, err := json.Marshal(hostGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/hostGroups/add", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newHostGroup := HostGroup{}
-
-	err = json.Unmarshal(body, &newHostGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	newHostGroupModel := mapToHostGroupModel(newHostGroup)
-
-	return &newHostGroupModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateMaintenancePeriod(maintenancePeriodModel MaintenancePeriodModel, ctx context.Context) (*MaintenancePeriodModel, error) {
-	maintenancePeriod := mapToMaintenancePeriod(maintenancePeriodModel)
-
-	rb, err := json.Marshal(maintenancePeriod)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/maintenancePeriods/add", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newMaintenancePeriod := MaintenancePeriod{}
-
-	err = json.Unmarshal(body, &newMaintenancePeriod)
-	if err != nil {
-		return nil, err
-	}
-
-	newMaintenancePeriodModel := mapToMaintenancePeriodModel(newMaintenancePeriod)
-
-	return &newMaintenancePeriodModel, nil
-}
-
-func (c *EndPointMonitorClient) CreatePingCheck(checkModel PingCheckModel, ctx context.Context) (*PingCheckModel, error) {
-	check := mapToPingCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/add/ping", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := PingCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToPingCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateProxyHost(proxyHostModel ProxyHostModel, ctx context.Context) (*ProxyHostModel, error) {
-	proxyHost := mapToProxyHost(proxyHostModel)
-
-	rb, err := json.Marshal(proxyHost)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/proxies/add", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newProxyHost := ProxyHost{}
-
-	err = json.Unmarshal(body, &newProxyHost)
-	if err != nil {
-		return nil, err
-	}
-
-	newProxyHostModel := mapToProxyHostModel(newProxyHost)
-
-	return &newProxyHostModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateSocketCheck(checkModel SocketCheckModel, ctx context.Context) (*SocketCheckModel, error) {
-	check := mapToSocketCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/add/socket", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := SocketCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToSocketCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateUrlCheck(checkModel UrlCheckModel, ctx context.Context) (*UrlCheckModel, error) {
-	check := mapToUrlCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/add/url", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := UrlCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToUrlCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateAndroidJourneyCheck(checkModel AndroidJourneyCheckModel, ctx context.Context) (*AndroidJourneyCheckModel, error) {
-	check := mapToAndroidJourneyCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/add/androidJourney", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	c.HTTPClient.Timeout = time.Minute * 5
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := AndroidJourneyCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToAndroidJourneyCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateWebJourneyCheck(checkModel WebJourneyCheckModel, ctx context.Context) (*WebJourneyCheckModel, error) {
-	check := mapToWebJourneyCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/add/webJourney", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := WebJourneyCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToWebJourneyCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateAndroidJourneyCommonStep(stepModel AndroidJourneyCommonStepModel, ctx context.Context) (*AndroidJourneyCommonStepModel, error) {
-	step := mapToAndroidJourneyCommonStep(stepModel)
-
-	rb, err := json.Marshal(step)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/commonSteps/android/add", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newStep := AndroidJourneyCommonStep{}
-
-	err = json.Unmarshal(body, &newStep)
-	if err != nil {
-		return nil, err
-	}
-
-	newStepModel := mapToAndroidJourneyCommonStepModel(newStep)
-
-	return &newStepModel, nil
-}
-
-func (c *EndPointMonitorClient) CreateWebJourneyCommonStep(stepModel WebJourneyCommonStepModel, ctx context.Context) (*WebJourneyCommonStepModel, error) {
-	step := mapToWebJourneyCommonStep(stepModel)
-
-	rb, err := json.Marshal(step)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/checks/commonSteps/web/add", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newStep := WebJourneyCommonStep{}
-
-	err = json.Unmarshal(body, &newStep)
-	if err != nil {
-		return nil, err
-	}
-
-	newStepModel := mapToWebJourneyCommonStepModel(newStep)
-
-	return &newStepModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateCheckGroup(checkGroupModel CheckGroupModel, ctx context.Context) (*CheckGroupModel, error) {
-	checkGroup := mapToCheckGroup(checkGroupModel)
-
-	rb, err := json.Marshal(checkGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checkGroups/update", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckGroup := CheckGroup{}
-
-	err = json.Unmarshal(body, &newCheckGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckGroupModel := mapToCheckGroupModel(newCheckGroup)
-
-	return &newCheckGroupModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateCheckHost(checkHostModel CheckHostModel, ctx context.Context) (*CheckHostModel, error) {
-	checkHost := mapToCheckHost(checkHostModel)
-
-	rb, err := json.Marshal(checkHost)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/hosts/update", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckHost := CheckHost{}
-
-	err = json.Unmarshal(body, &newCheckHost)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckHostModel := mapToCheckHostModel(newCheckHost)
-
-	return &newCheckHostModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateCertificateCheck(checkModel CertificateCheckModel, ctx context.Context) (*CertificateCheckModel, error) {
-	check := mapToCertificateCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/update/certificate", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := CertificateCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToCertificateCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateDashboardGroup(dashboardGroupModel DashboardGroupModel, ctx context.Context) (*DashboardGroupModel, error) {
-	dashboardGroup := mapToDashboardGroup(dashboardGroupModel)
-
-	rb, err := json.Marshal(dashboardGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/dashboardGroups/update", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newDashboardGroup := DashboardGroup{}
-
-	err = json.Unmarshal(body, &newDashboardGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	newDashboardGroupModel := mapToDashboardGroupModel(newDashboardGroup)
-
-	return &newDashboardGroupModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateDnsCheck(checkModel DnsCheckModel, ctx context.Context) (*DnsCheckModel, error) {
-	check := mapToDnsCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/update/dns", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := DnsCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToDnsCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateHostGroup(hostGroupModel HostGroupModel, ctx context.Context) (*HostGroupModel, error) {
-	hostGroup := mapToHostGroup(hostGroupModel)
-
-	rb, err := json.Marshal(hostGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/hostGroups/update", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newHostGroup := HostGroup{}
-
-	err = json.Unmarshal(body, &newHostGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	newHostGroupModel := mapToHostGroupModel(newHostGroup)
-
-	return &newHostGroupModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateMaintenancePeriod(maintenancePeriodModel MaintenancePeriodModel, ctx context.Context) (*MaintenancePeriodModel, error) {
-	maintenancePeriod := mapToMaintenancePeriod(maintenancePeriodModel)
-
-	rb, err := json.Marshal(maintenancePeriod)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/maintenancePeriods/update", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newMaintenancePeriod := MaintenancePeriod{}
-
-	err = json.Unmarshal(body, &newMaintenancePeriod)
-	if err != nil {
-		return nil, err
-	}
-
-	newMaintenancePeriodModel := mapToMaintenancePeriodModel(newMaintenancePeriod)
-
-	return &newMaintenancePeriodModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdatePingCheck(checkModel PingCheckModel, ctx context.Context) (*PingCheckModel, error) {
-	check := mapToPingCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/update/ping", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := PingCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToPingCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateProxyHost(proxyHostModel ProxyHostModel, ctx context.Context) (*ProxyHostModel, error) {
-	proxyHost := mapToProxyHost(proxyHostModel)
-
-	rb, err := json.Marshal(proxyHost)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/proxies/update", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newProxyHost := ProxyHost{}
-
-	err = json.Unmarshal(body, &newProxyHost)
-	if err != nil {
-		return nil, err
-	}
-
-	newProxyHostModel := mapToProxyHostModel(newProxyHost)
-
-	return &newProxyHostModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateSocketCheck(checkModel SocketCheckModel, ctx context.Context) (*SocketCheckModel, error) {
-	check := mapToSocketCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/update/socket", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := SocketCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToSocketCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateUrlCheck(checkModel UrlCheckModel, ctx context.Context) (*UrlCheckModel, error) {
-	check := mapToUrlCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/update/url", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := UrlCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToUrlCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateAndroidJourneyCheck(checkModel AndroidJourneyCheckModel, ctx context.Context) (*AndroidJourneyCheckModel, error) {
-	check := mapToAndroidJourneyCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/update/androidJourney", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	c.HTTPClient.Timeout = time.Minute * 5
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := AndroidJourneyCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToAndroidJourneyCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateWebJourneyCheck(checkModel WebJourneyCheckModel, ctx context.Context) (*WebJourneyCheckModel, error) {
-	check := mapToWebJourneyCheck(checkModel)
-
-	rb, err := json.Marshal(check)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/update/webJourney", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheck := WebJourneyCheck{}
-
-	err = json.Unmarshal(body, &newCheck)
-	if err != nil {
-		return nil, err
-	}
-
-	newCheckModel := mapToWebJourneyCheckModel(newCheck)
-
-	return &newCheckModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateAndroidJourneyCommonStep(stepModel AndroidJourneyCommonStepModel, ctx context.Context) (*AndroidJourneyCommonStepModel, error) {
-	step := mapToAndroidJourneyCommonStep(stepModel)
-
-	rb, err := json.Marshal(step)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/commonSteps/android/update", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newStep := AndroidJourneyCommonStep{}
-
-	err = json.Unmarshal(body, &newStep)
-	if err != nil {
-		return nil, err
-	}
-
-	newStepModel := mapToAndroidJourneyCommonStepModel(newStep)
-
-	return &newStepModel, nil
-}
-
-func (c *EndPointMonitorClient) UpdateWebJourneyCommonStep(stepModel WebJourneyCommonStepModel, ctx context.Context) (*WebJourneyCommonStepModel, error) {
-	step := mapToWebJourneyCommonStep(stepModel)
-
-	rb, err := json.Marshal(step)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/checks/commonSteps/web/update", c.HostURL), strings.NewReader(string(rb)))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	newStep := WebJourneyCommonStep{}
-
-	err = json.Unmarshal(body, &newStep)
+	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return res, nil, attemptError(ctx, req.Context(), timeout, err)
 	}
 
-	newStepModel := mapToWebJourneyCommonStepModel(newStep)
+	return res, body, nil
+}
+
+type attemptTimeoutKey struct{}
 
-	return &newStepModel, nil
+// withAttemptTimeout sets the least time given to each attempt at the requests made
+// with ctx, in place of defaultAttemptTimeout.
+func withAttemptTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, attemptTimeoutKey{}, timeout)
 }
 
-func (c *EndPointMonitorClient) DeleteCheck(id int64) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/checks/remove/%d", c.HostURL, id), nil)
-	if err != nil {
-		return err
+// attemptTimeout works out the deadline of an attempt at a request, sharing the time
+// left before the deadline of ctx equally between this and any attempts that may
+// follow it, so a long operation timeout gives each attempt longer.
+func (c *EndPointMonitorClient) attemptTimeout(ctx context.Context, attempt int) time.Duration {
+	timeout := defaultAttemptTimeout
+	if least, ok := ctx.Value(attemptTimeoutKey{}).(time.Duration); ok {
+		timeout = least
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
+	if deadline, ok := ctx.Deadline(); ok {
+		attemptsLeft := max(c.MaxRetries-attempt, 0) + 1
+		timeout = max(timeout, time.Until(deadline)/time.Duration(attemptsLeft))
 	}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
+	return timeout
+}
+
+// attemptError replaces the error from an attempt that ran out of time with
+// errAttemptTimeout when the operation it is part of still has time left, so that it
+// can be retried.
+func attemptError(attemptCtx context.Context, operationCtx context.Context, timeout time.Duration, err error) error {
+	if timeout > 0 && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) && operationCtx.Err() == nil {
+		return fmt.Errorf("%w: no response within %s, the time allowed for each attempt at the request", errAttemptTimeout, timeout)
 	}
 
-	return nil
+	return err
 }
 
-func (c *EndPointMonitorClient) DeleteCheckGroup(id int32) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/checkGroups/remove/%d", c.HostURL, id), nil)
+// GetCheck reads a check of any type. The attributes specific to its type are only
+// set for the types of check the provider supports.
+func (c *EndPointMonitorClient) GetCheck(id int64, ctx context.Context) (*CheckDataSourceModel, error) {
+	var data json.RawMessage
+
+	err := c.requestJSON(http.MethodGet, fmt.Sprintf("/checks/%d", id), nil, &data, ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
+	var check Check
+	if err := json.Unmarshal(data, &check); err != nil {
+		return nil, fmt.Errorf("unable to read check %d: %w", id, err)
 	}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
+	c.Naming.strip(&check.Name, &check.Description)
+	checkModel := mapToCheckDataSourceModel(check)
+
+	switch check.CheckType {
+	case "URL":
+		model, err := urlCheckEndpoint.decode(c, data)
+		if err != nil {
+			return nil, err
+		}
+		checkModel.UrlCheck = mapToUrlCheckDataModel(*model)
+	case "DNS":
+		model, err := dnsCheckEndpoint.decode(c, data)
+		if err != nil {
+			return nil, err
+		}
+		checkModel.DnsCheck = mapToDnsCheckDataModel(*model)
+	case "PING":
+		model, err := pingCheckEndpoint.decode(c, data)
+		if err != nil {
+			return nil, err
+		}
+		checkModel.PingCheck = mapToPingCheckDataModel(*model)
+	case "SOCKET":
+		model, err := socketCheckEndpoint.decode(c, data)
+		if err != nil {
+			return nil, err
+		}
+		checkModel.SocketCheck = mapToSocketCheckDataModel(*model)
+	case "TLS_CERTIFICATE":
+		model, err := certificateCheckEndpoint.decode(c, data)
+		if err != nil {
+			return nil, err
+		}
+		checkModel.CertificateCheck = mapToCertificateCheckDataModel(*model)
+	case "WEB_JOURNEY":
+		model, err := webJourneyCheckEndpoint.decode(c, data)
+		if err != nil {
+			return nil, err
+		}
+		checkModel.WebJourneyCheck = mapToWebJourneyCheckDataModel(*model)
+	case "ANDROID_JOURNEY":
+		model, err := androidJourneyCheckEndpoint.decode(c, data)
+		if err != nil {
+			return nil, err
+		}
+		checkModel.AndroidJourneyCheck = mapToAndroidJourneyCheckDataModel(*model)
 	}
 
-	return nil
+	return &checkModel, nil
 }
 
-func (c *EndPointMonitorClient) DeleteCheckHost(id int32) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/hosts/remove/%d", c.HostURL, id), nil)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) GetCheckGroup(id int32, ctx context.Context) (*CheckGroupModel, error) {
+	return checkGroupEndpoint.get(c, int64(id), ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) GetCheckHost(id int32, ctx context.Context) (*CheckHostModel, error) {
+	return checkHostEndpoint.get(c, int64(id), ctx)
+}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
-	}
+func (c *EndPointMonitorClient) GetDashboardGroup(id int32, ctx context.Context) (*DashboardGroupModel, error) {
+	return dashboardGroupEndpoint.get(c, int64(id), ctx)
+}
 
-	return nil
+func (c *EndPointMonitorClient) GetCertificateCheck(id int64, ctx context.Context) (*CertificateCheckModel, error) {
+	return certificateCheckEndpoint.get(c, id, ctx)
 }
 
-func (c *EndPointMonitorClient) DeleteAndroidCommonStep(id int64) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/checks/commonSteps/android/remove/%d", c.HostURL, id), nil)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) GetDnsCheck(id int64, ctx context.Context) (*DnsCheckModel, error) {
+	return dnsCheckEndpoint.get(c, id, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) GetHostGroup(id int32, ctx context.Context) (*HostGroupModel, error) {
+	return hostGroupEndpoint.get(c, int64(id), ctx)
+}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
-	}
+func (c *EndPointMonitorClient) GetMaintenancePeriod(id int32, ctx context.Context) (*MaintenancePeriodModel, error) {
+	return maintenancePeriodEndpoint.get(c, int64(id), ctx)
+}
 
-	return nil
+func (c *EndPointMonitorClient) GetPingCheck(id int64, ctx context.Context) (*PingCheckModel, error) {
+	return pingCheckEndpoint.get(c, id, ctx)
 }
 
-func (c *EndPointMonitorClient) DeleteWebCommonStep(id int64) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/checks/commonSteps/web/remove/%d", c.HostURL, id), nil)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) GetProxyHost(id int32, ctx context.Context) (*ProxyHostModel, error) {
+	return proxyHostEndpoint.get(c, int64(id), ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) GetSocketCheck(id int64, ctx context.Context) (*SocketCheckModel, error) {
+	return socketCheckEndpoint.get(c, id, ctx)
+}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
-	}
+func (c *EndPointMonitorClient) GetUrlCheck(id int64, ctx context.Context) (*UrlCheckModel, error) {
+	return urlCheckEndpoint.get(c, id, ctx)
+}
 
-	return nil
+func (c *EndPointMonitorClient) GetAndroidJourneyCheck(id int64, ctx context.Context) (*AndroidJourneyCheckModel, error) {
+	return androidJourneyCheckEndpoint.get(c, id, ctx)
 }
 
-func (c *EndPointMonitorClient) DeleteDashboardGroup(id int32) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/dashboardGroups/remove/%d", c.HostURL, id), nil)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) GetWebJourneyCheck(id int64, ctx context.Context) (*WebJourneyCheckModel, error) {
+	return webJourneyCheckEndpoint.get(c, id, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) GetCommonAndroidJourneyStep(id int64, ctx context.Context) (*AndroidJourneyCommonStepModel, error) {
+	return androidJourneyCommonStepEndpoint.get(c, id, ctx)
+}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
-	}
+func (c *EndPointMonitorClient) GetCommonWebJourneyStep(id int64, ctx context.Context) (*WebJourneyCommonStepModel, error) {
+	return webJourneyCommonStepEndpoint.get(c, id, ctx)
+}
 
-	return nil
+func (c *EndPointMonitorClient) CreateCheckGroup(checkGroupModel CheckGroupModel, ctx context.Context) (*CheckGroupModel, error) {
+	return checkGroupEndpoint.create(c, checkGroupModel, ctx)
 }
 
-func (c *EndPointMonitorClient) DeleteHostGroup(id int32) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/hostGroups/remove/%d", c.HostURL, id), nil)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) CreateCheckHost(checkHostModel CheckHostModel, ctx context.Context) (*CheckHostModel, error) {
+	return checkHostEndpoint.create(c, checkHostModel, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) CreateCertificateCheck(checkModel CertificateCheckModel, ctx context.Context) (*CertificateCheckModel, error) {
+	return certificateCheckEndpoint.create(c, checkModel, ctx)
+}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
-	}
+func (c *EndPointMonitorClient) CreateDashboardGroup(dashboardGroupModel DashboardGroupModel, ctx context.Context) (*DashboardGroupModel, error) {
+	return dashboardGroupEndpoint.create(c, dashboardGroupModel, ctx)
+}
 
-	return nil
+func (c *EndPointMonitorClient) CreateDnsCheck(checkModel DnsCheckModel, ctx context.Context) (*DnsCheckModel, error) {
+	return dnsCheckEndpoint.create(c, checkModel, ctx)
 }
 
-func (c *EndPointMonitorClient) DeleteMaintenancePeriod(id int32) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/maintenancePeriods/remove/%d", c.HostURL, id), nil)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) CreateHostGroup(hostGroupModel HostGroupModel, ctx context.Context) (*HostGroupModel, error) {
+	return hostGroupEndpoint.create(c, hostGroupModel, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) CreateMaintenancePeriod(maintenancePeriodModel MaintenancePeriodModel, ctx context.Context) (*MaintenancePeriodModel, error) {
+	return maintenancePeriodEndpoint.create(c, maintenancePeriodModel, ctx)
+}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
-	}
+func (c *EndPointMonitorClient) CreatePingCheck(checkModel PingCheckModel, ctx context.Context) (*PingCheckModel, error) {
+	return pingCheckEndpoint.create(c, checkModel, ctx)
+}
 
-	return nil
+func (c *EndPointMonitorClient) CreateProxyHost(proxyHostModel ProxyHostModel, ctx context.Context) (*ProxyHostModel, error) {
+	return proxyHostEndpoint.create(c, proxyHostModel, ctx)
 }
 
-func (c *EndPointMonitorClient) DeleteProxyHost(id int32) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/proxies/remove/%d", c.HostURL, id), nil)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) CreateSocketCheck(checkModel SocketCheckModel, ctx context.Context) (*SocketCheckModel, error) {
+	return socketCheckEndpoint.create(c, checkModel, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return err
-	}
+func (c *EndPointMonitorClient) CreateUrlCheck(checkModel UrlCheckModel, ctx context.Context) (*UrlCheckModel, error) {
+	return urlCheckEndpoint.create(c, checkModel, ctx)
+}
 
-	if string(body) != "{\"success\":true}" {
-		return errors.New(string(body))
-	}
+func (c *EndPointMonitorClient) CreateAndroidJourneyCheck(checkModel AndroidJourneyCheckModel, ctx context.Context) (*AndroidJourneyCheckModel, error) {
+	return androidJourneyCheckEndpoint.create(c, checkModel, withAttemptTimeout(ctx, apkUploadAttemptTimeout))
+}
 
-	return nil
+func (c *EndPointMonitorClient) CreateWebJourneyCheck(checkModel WebJourneyCheckModel, ctx context.Context) (*WebJourneyCheckModel, error) {
+	return webJourneyCheckEndpoint.create(c, checkModel, ctx)
 }
 
-func (c *EndPointMonitorClient) SearchCheckGroups(search string) ([]types.Int32, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checkGroups/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) CreateAndroidJourneyCommonStep(stepModel AndroidJourneyCommonStepModel, ctx context.Context) (*AndroidJourneyCommonStepModel, error) {
+	return androidJourneyCommonStepEndpoint.create(c, stepModel, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) CreateWebJourneyCommonStep(stepModel WebJourneyCommonStepModel, ctx context.Context) (*WebJourneyCommonStepModel, error) {
+	return webJourneyCommonStepEndpoint.create(c, stepModel, ctx)
+}
 
-	checkGroups := []CheckGroup{}
+func (c *EndPointMonitorClient) UpdateCheckGroup(checkGroupModel CheckGroupModel, ctx context.Context) (*CheckGroupModel, error) {
+	return checkGroupEndpoint.update(c, checkGroupModel, ctx)
+}
 
-	if body != nil {
-		err = json.Unmarshal(body, &checkGroups)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+func (c *EndPointMonitorClient) UpdateCheckHost(checkHostModel CheckHostModel, ctx context.Context) (*CheckHostModel, error) {
+	return checkHostEndpoint.update(c, checkHostModel, ctx)
+}
 
-	ids := make([]types.Int32, 0, len(checkGroups))
+func (c *EndPointMonitorClient) UpdateCertificateCheck(checkModel CertificateCheckModel, ctx context.Context) (*CertificateCheckModel, error) {
+	return certificateCheckEndpoint.update(c, checkModel, ctx)
+}
 
-	for _, check := range checkGroups {
-		ids = append(ids, types.Int32Value(int32(check.Id)))
-	}
+func (c *EndPointMonitorClient) UpdateDashboardGroup(dashboardGroupModel DashboardGroupModel, ctx context.Context) (*DashboardGroupModel, error) {
+	return dashboardGroupEndpoint.update(c, dashboardGroupModel, ctx)
+}
 
-	return ids, nil
+func (c *EndPointMonitorClient) UpdateDnsCheck(checkModel DnsCheckModel, ctx context.Context) (*DnsCheckModel, error) {
+	return dnsCheckEndpoint.update(c, checkModel, ctx)
 }
 
-func (c *EndPointMonitorClient) SearchCheckHosts(search string) ([]types.Int32, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/hosts/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) UpdateHostGroup(hostGroupModel HostGroupModel, ctx context.Context) (*HostGroupModel, error) {
+	return hostGroupEndpoint.update(c, hostGroupModel, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) UpdateMaintenancePeriod(maintenancePeriodModel MaintenancePeriodModel, ctx context.Context) (*MaintenancePeriodModel, error) {
+	return maintenancePeriodEndpoint.update(c, maintenancePeriodModel, ctx)
+}
 
-	checkHosts := []CheckHost{}
+func (c *EndPointMonitorClient) UpdatePingCheck(checkModel PingCheckModel, ctx context.Context) (*PingCheckModel, error) {
+	return pingCheckEndpoint.update(c, checkModel, ctx)
+}
 
-	if body != nil {
-		err = json.Unmarshal(body, &checkHosts)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+func (c *EndPointMonitorClient) UpdateProxyHost(proxyHostModel ProxyHostModel, ctx context.Context) (*ProxyHostModel, error) {
+	return proxyHostEndpoint.update(c, proxyHostModel, ctx)
+}
 
-	ids := make([]types.Int32, 0, len(checkHosts))
+func (c *EndPointMonitorClient) UpdateSocketCheck(checkModel SocketCheckModel, ctx context.Context) (*SocketCheckModel, error) {
+	return socketCheckEndpoint.update(c, checkModel, ctx)
+}
 
-	for _, check := range checkHosts {
-		ids = append(ids, types.Int32Value(int32(check.Id)))
-	}
+func (c *EndPointMonitorClient) UpdateUrlCheck(checkModel UrlCheckModel, ctx context.Context) (*UrlCheckModel, error) {
+	return urlCheckEndpoint.update(c, checkModel, ctx)
+}
 
-	return ids, nil
+func (c *EndPointMonitorClient) UpdateAndroidJourneyCheck(checkModel AndroidJourneyCheckModel, ctx context.Context) (*AndroidJourneyCheckModel, error) {
+	return androidJourneyCheckEndpoint.update(c, checkModel, withAttemptTimeout(ctx, apkUploadAttemptTimeout))
 }
 
-func (c *EndPointMonitorClient) SearchChecks(search string) ([]types.Int64, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) UpdateWebJourneyCheck(checkModel WebJourneyCheckModel, ctx context.Context) (*WebJourneyCheckModel, error) {
+	return webJourneyCheckEndpoint.update(c, checkModel, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) UpdateAndroidJourneyCommonStep(stepModel AndroidJourneyCommonStepModel, ctx context.Context) (*AndroidJourneyCommonStepModel, error) {
+	return androidJourneyCommonStepEndpoint.update(c, stepModel, ctx)
+}
 
-	checks := []Check{}
+func (c *EndPointMonitorClient) UpdateWebJourneyCommonStep(stepModel WebJourneyCommonStepModel, ctx context.Context) (*WebJourneyCommonStepModel, error) {
+	return webJourneyCommonStepEndpoint.update(c, stepModel, ctx)
+}
 
-	if body != nil {
-		err = json.Unmarshal(body, &checks)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+func (c *EndPointMonitorClient) DeleteCheck(id int64, ctx context.Context) error {
+	return checkEndpoint.delete(c, id, ctx)
+}
 
-	ids := make([]types.Int64, 0, len(checks))
+func (c *EndPointMonitorClient) DeleteCheckGroup(id int32, ctx context.Context) error {
+	return checkGroupEndpoint.delete(c, int64(id), ctx)
+}
 
-	for _, check := range checks {
-		ids = append(ids, types.Int64Value(int64(check.Id)))
-	}
+func (c *EndPointMonitorClient) DeleteCheckHost(id int32, ctx context.Context) error {
+	return checkHostEndpoint.delete(c, int64(id), ctx)
+}
 
-	return ids, nil
+func (c *EndPointMonitorClient) DeleteAndroidCommonStep(id int64, ctx context.Context) error {
+	return androidJourneyCommonStepEndpoint.delete(c, id, ctx)
 }
 
-func (c *EndPointMonitorClient) SearchDashboardGroups(search string) ([]types.Int32, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dashboardGroups/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) DeleteWebCommonStep(id int64, ctx context.Context) error {
+	return webJourneyCommonStepEndpoint.delete(c, id, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) DeleteDashboardGroup(id int32, ctx context.Context) error {
+	return dashboardGroupEndpoint.delete(c, int64(id), ctx)
+}
 
-	dashboardGroups := []DashboardGroup{}
+func (c *EndPointMonitorClient) DeleteHostGroup(id int32, ctx context.Context) error {
+	return hostGroupEndpoint.delete(c, int64(id), ctx)
+}
 
-	if body != nil {
-		err = json.Unmarshal(body, &dashboardGroups)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+func (c *EndPointMonitorClient) DeleteMaintenancePeriod(id int32, ctx context.Context) error {
+	return maintenancePeriodEndpoint.delete(c, int64(id), ctx)
+}
 
-	ids := make([]types.Int32, 0, len(dashboardGroups))
+func (c *EndPointMonitorClient) DeleteProxyHost(id int32, ctx context.Context) error {
+	return proxyHostEndpoint.delete(c, int64(id), ctx)
+}
 
-	for _, check := range dashboardGroups {
-		ids = append(ids, types.Int32Value(int32(check.Id)))
+func (c *EndPointMonitorClient) SearchCheckGroups(search string, limit int, ctx context.Context) ([]types.Int32, error) {
+	ids, err := checkGroupEndpoint.searchIds(c, search, limit, ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return ids, nil
+	return int32Values(ids), nil
 }
 
-func (c *EndPointMonitorClient) SearchHostGroups(search string) ([]types.Int32, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/hostGroups/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
+func (c *EndPointMonitorClient) SearchCheckHosts(search string, limit int, ctx context.Context) ([]types.Int32, error) {
+	ids, err := checkHostEndpoint.searchIds(c, search, limit, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	return int32Values(ids), nil
+}
+
+func (c *EndPointMonitorClient) SearchChecks(search string, limit int, ctx context.Context) ([]types.Int64, error) {
+	ids, err := checkEndpoint.searchIds(c, search, limit, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	hostGroups := []HostGroup{}
+	return int64Values(ids), nil
+}
 
-	if body != nil {
-		err = json.Unmarshal(body, &hostGroups)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
+// FindChecksByName returns the ids of the checks with exactly the given name, once the
+// provider's naming settings are removed from them.
+func (c *EndPointMonitorClient) FindChecksByName(name string, ctx context.Context) ([]types.Int64, error) {
+	checks, err := c.ListChecks(name, 0, func(check Check) bool { return check.Name == name }, ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	ids := make([]types.Int32, 0, len(hostGroups))
+	ids := []types.Int64{}
 
-	for _, check := range hostGroups {
-		ids = append(ids, types.Int32Value(int32(check.Id)))
+	for _, check := range checks {
+		ids = append(ids, types.Int64Value(check.Id))
 	}
 
 	return ids, nil
 }
 
-func (c *EndPointMonitorClient) SearchMaintenancePeriods(search string) ([]types.Int32, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/maintenancePeriods/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
+func (c *EndPointMonitorClient) SearchDashboardGroups(search string, limit int, ctx context.Context) ([]types.Int32, error) {
+	ids, err := dashboardGroupEndpoint.searchIds(c, search, limit, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	return int32Values(ids), nil
+}
+
+func (c *EndPointMonitorClient) SearchHostGroups(search string, limit int, ctx context.Context) ([]types.Int32, error) {
+	ids, err := hostGroupEndpoint.searchIds(c, search, limit, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	maintenancePeriod := []MaintenancePeriod{}
+	return int32Values(ids), nil
+}
 
-	if body != nil {
-		err = json.Unmarshal(body, &maintenancePeriod)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
+func (c *EndPointMonitorClient) SearchMaintenancePeriods(search string, limit int, ctx context.Context) ([]types.Int32, error) {
+	ids, err := maintenancePeriodEndpoint.searchIds(c, search, limit, ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	ids := make([]types.Int32, 0, len(maintenancePeriod))
+	return int32Values(ids), nil
+}
 
-	for _, check := range maintenancePeriod {
-		ids = append(ids, types.Int32Value(int32(check.Id)))
+func (c *EndPointMonitorClient) SearchProxyHosts(search string, limit int, ctx context.Context) ([]types.Int32, error) {
+	ids, err := proxyHostEndpoint.searchIds(c, search, limit, ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return ids, nil
+	return int32Values(ids), nil
 }
 
-func (c *EndPointMonitorClient) SearchProxyHosts(search string) ([]types.Int32, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/proxies/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
+func (c *EndPointMonitorClient) SearchAndroidJoureyCommonSteps(search string, limit int, ctx context.Context) ([]types.Int32, error) {
+	ids, err := androidJourneyCommonStepEndpoint.searchIds(c, search, limit, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	return int32Values(ids), nil
+}
+
+func (c *EndPointMonitorClient) SearchWebJoureyCommonSteps(search string, limit int, ctx context.Context) ([]types.Int32, error) {
+	ids, err := webJourneyCommonStepEndpoint.searchIds(c, search, limit, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	proxyHost := []ProxyHost{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &proxyHost)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+	return int32Values(ids), nil
+}
 
-	ids := make([]types.Int32, 0, len(proxyHost))
+// The List methods return the items whose names match the search string and for which
+// keep returns true, for the plural data sources to filter on more than their names.
 
-	for _, check := range proxyHost {
-		ids = append(ids, types.Int32Value(int32(check.Id)))
-	}
+func (c *EndPointMonitorClient) ListChecks(search string, limit int, keep func(Check) bool, ctx context.Context) ([]Check, error) {
+	return checkEndpoint.search(c, search, limit, keep, ctx)
+}
 
-	return ids, nil
+func (c *EndPointMonitorClient) ListCheckGroups(search string, limit int, keep func(CheckGroup) bool, ctx context.Context) ([]CheckGroup, error) {
+	return checkGroupEndpoint.search(c, search, limit, keep, ctx)
 }
 
-func (c *EndPointMonitorClient) SearchAndroidJoureyCommonSteps(search string) ([]types.Int32, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/commonSteps/android/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) ListCheckHosts(search string, limit int, keep func(CheckHost) bool, ctx context.Context) ([]CheckHost, error) {
+	return checkHostEndpoint.search(c, search, limit, keep, ctx)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (c *EndPointMonitorClient) ListDashboardGroups(search string, limit int, keep func(DashboardGroup) bool, ctx context.Context) ([]DashboardGroup, error) {
+	return dashboardGroupEndpoint.search(c, search, limit, keep, ctx)
+}
 
-	commonStep := []AndroidJourneyCommonStep{}
+func (c *EndPointMonitorClient) ListHostGroups(search string, limit int, keep func(HostGroup) bool, ctx context.Context) ([]HostGroup, error) {
+	return hostGroupEndpoint.search(c, search, limit, keep, ctx)
+}
 
-	if body != nil {
-		err = json.Unmarshal(body, &commonStep)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+func (c *EndPointMonitorClient) ListMaintenancePeriods(search string, limit int, keep func(MaintenancePeriod) bool, ctx context.Context) ([]MaintenancePeriod, error) {
+	return maintenancePeriodEndpoint.search(c, search, limit, keep, ctx)
+}
 
-	ids := make([]types.Int32, 0, len(commonStep))
+func (c *EndPointMonitorClient) ListProxyHosts(search string, limit int, keep func(ProxyHost) bool, ctx context.Context) ([]ProxyHost, error) {
+	return proxyHostEndpoint.search(c, search, limit, keep, ctx)
+}
 
-	for _, check := range commonStep {
-		ids = append(ids, types.Int32Value(int32(check.Id)))
-	}
+func (c *EndPointMonitorClient) ListAndroidJourneyCommonSteps(search string, limit int, keep func(AndroidJourneyCommonStep) bool, ctx context.Context) ([]AndroidJourneyCommonStep, error) {
+	return androidJourneyCommonStepEndpoint.search(c, search, limit, keep, ctx)
+}
 
-	return ids, nil
+func (c *EndPointMonitorClient) ListWebJourneyCommonSteps(search string, limit int, keep func(WebJourneyCommonStep) bool, ctx context.Context) ([]WebJourneyCommonStep, error) {
+	return webJourneyCommonStepEndpoint.search(c, search, limit, keep, ctx)
 }
 
-func (c *EndPointMonitorClient) SearchWebJoureyCommonSteps(search string) ([]types.Int32, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/checks/commonSteps/web/list?page=0&search=%s", c.HostURL, url.QueryEscape(search)), nil)
-	if err != nil {
-		return nil, err
-	}
+func int32Values(ids []int64) []types.Int32 {
+	values := make([]types.Int32, 0, len(ids))
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+	for _, id := range ids {
+		values = append(values, types.Int32Value(int32(id)))
 	}
 
-	commonStep := []WebJourneyCommonStep{}
-
-	if body != nil {
-		err = json.Unmarshal(body, &commonStep)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, nil
-	}
+	return values
+}
 
-	ids := make([]types.Int32, 0, len(commonStep))
+func int64Values(ids []int64) []types.Int64 {
+	values := make([]types.Int64, 0, len(ids))
 
-	for _, check := range commonStep {
-		ids = append(ids, types.Int32Value(int32(check.Id)))
+	for _, id := range ids {
+		values = append(values, types.Int64Value(id))
 	}
 
-	return ids, nil
+	return values
 }
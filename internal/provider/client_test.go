@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAttemptTimeout(t *testing.T) {
+	c := &EndPointMonitorClient{MaxRetries: 3}
+	background := context.Background()
+
+	if got := c.attemptTimeout(background, 0); got != defaultAttemptTimeout {
+		t.Errorf("without an operation deadline got %s, want %s", got, defaultAttemptTimeout)
+	}
+
+	if got := c.attemptTimeout(withAttemptTimeout(background, apkUploadAttemptTimeout), 0); got != apkUploadAttemptTimeout {
+		t.Errorf("with an attempt timeout got %s, want %s", got, apkUploadAttemptTimeout)
+	}
+
+	// A 20 minute operation shares its time between the four attempts it may make.
+	ctx, cancel := context.WithTimeout(background, 20*time.Minute)
+	defer cancel()
+
+	if got := c.attemptTimeout(ctx, 0); got < 4*time.Minute || got > 5*time.Minute {
+		t.Errorf("first of four attempts got %s, want about 5m", got)
+	}
+
+	if got := c.attemptTimeout(ctx, 3); got < 19*time.Minute {
+		t.Errorf("last attempt got %s, want the rest of the operation", got)
+	}
+
+	// An attempt is never given less than the least time for each attempt, but the
+	// operation's own deadline still applies.
+	short, cancel := context.WithTimeout(background, time.Minute)
+	defer cancel()
+
+	if got := c.attemptTimeout(short, 0); got != defaultAttemptTimeout {
+		t.Errorf("short operation got %s, want %s", got, defaultAttemptTimeout)
+	}
+}
+
+// testSlowServer serves an empty JSON object after the given delay, or as soon as the
+// request is cancelled.
+func testSlowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAttemptTimeoutOnlyLimitsRetryableRequests(t *testing.T) {
+	server := testSlowServer(t, 200*time.Millisecond)
+
+	client, err := NewEPMClient(EndPointMonitorClientConfig{HostURL: server.URL, ApiKey: testAccKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := withAttemptTimeout(context.Background(), 50*time.Millisecond)
+	var out map[string]any
+
+	// A GET is given up on once its attempt runs out of time, naming the limit on the
+	// attempt rather than the operation.
+	err = client.requestJSON(http.MethodGet, "/dashboardGroups/1", nil, &out, ctx)
+	if !errors.Is(err, errAttemptTimeout) {
+		t.Fatalf("slow GET got %v, want an attempt timeout", err)
+	}
+
+	// A PUT can't be retried, so it waits for as long as the operation allows.
+	operation, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if err := client.requestJSON(http.MethodPut, "/dashboardGroups/add", map[string]any{}, &out, operation); err != nil {
+		t.Fatalf("slow PUT got %v, want it to wait for the response", err)
+	}
+
+	// A GET within a longer operation is given a share of its time.
+	if err := client.requestJSON(http.MethodGet, "/dashboardGroups/1", nil, &out, operation); err != nil {
+		t.Fatalf("slow GET within a 10s operation got %v", err)
+	}
+}
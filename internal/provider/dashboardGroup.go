@@ -23,3 +23,11 @@ func mapToDashboardGroupModel(dashboardGroup DashboardGroup) DashboardGroupModel
 		Description: types.StringValue(dashboardGroup.Description),
 	}
 }
+
+func mapToDashboardGroupSummaryModel(dashboardGroup DashboardGroup) NamedItemSummaryModel {
+	return NamedItemSummaryModel{
+		Id:          types.Int32Value(int32(dashboardGroup.Id)),
+		Name:        types.StringValue(dashboardGroup.Name),
+		Description: types.StringValue(dashboardGroup.Description),
+	}
+}
@@ -44,7 +44,7 @@ func (d *AndroidJourneyCommonStepDataSource) Read(ctx context.Context, req datas
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchAndroidJoureyCommonSteps(data.Search.ValueString())
+	ids, err := d.client.SearchAndroidJoureyCommonSteps(data.Search.ValueString(), 2, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching android journey common steps",
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccAndroidJourneyCommonStepDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	ids := []int64{
+		server.Add(fakeepm.AndroidJourneyCommonSteps, map[string]any{"name": "Accept Terms", "description": "Accepts the terms.", "waitTime": 5000, "stepChecks": []any{}, "stepInteractions": []any{}}),
+		server.Add(fakeepm.AndroidJourneyCommonSteps, map[string]any{"name": "Log In", "description": "Logs in.", "waitTime": 5000, "stepChecks": []any{}, "stepInteractions": []any{}}),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_android_journey_common_step" "test" {
+  search = %q
+}
+`, "Terms"),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_android_journey_common_step.test", "id", fmt.Sprint(ids[0])),
+			},
+			{
+				// Only a single item may be found.
+				Config: testAccConfig(server, `
+data "endpointmonitor_android_journey_common_step" "test" {
+  search = %q
+}
+`, "s"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_android_journey_common_step" "test" {
+  search = %q
+}
+`, "Nothing by this name"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+		},
+	})
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the desired interfaces.
@@ -13,7 +16,10 @@ func NewCheckDataSource() datasource.DataSource {
 	return &CheckDataSource{}
 }
 
-var _ datasource.DataSource = &CheckDataSource{}
+var (
+	_ datasource.DataSource                     = &CheckDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &CheckDataSource{}
+)
 
 type CheckDataSource struct {
 	client *EndPointMonitorClient
@@ -25,46 +31,271 @@ func (d *CheckDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 
 // Schema defines the schema for the data source.
 func (d *CheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	requestHeader := schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "Headers sent with the request.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name":  schema.StringAttribute{Computed: true},
+				"value": schema.StringAttribute{Computed: true},
+			},
+		},
+	}
+
+	responseBodyCheck := schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "Strings the response body is checked for.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"string":     schema.StringAttribute{Computed: true},
+				"comparator": schema.StringAttribute{Computed: true},
+			},
+		},
+	}
+
+	step := schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The steps of the journey in the order they run.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id":             schema.Int64Attribute{Computed: true},
+				"sequence":       schema.Int32Attribute{Computed: true},
+				"type":           schema.StringAttribute{Computed: true, Description: "Either COMMON or CUSTOM."},
+				"name":           schema.StringAttribute{Computed: true},
+				"common_step_id": schema.Int64Attribute{Computed: true, Description: "The id of the common step run, for COMMON steps."},
+				"page_check": schema.ListNestedAttribute{
+					Computed: true,
+					Description: "The checks made once the step has run, or the step checks of an Android journey. " +
+						"The settings specific to the type of check, such as the text looked for, aren't included. " +
+						"Empty for COMMON steps, whose checks are those of the common step.",
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id":           schema.Int64Attribute{Computed: true},
+							"type":         schema.StringAttribute{Computed: true},
+							"description":  schema.StringAttribute{Computed: true},
+							"warning_only": schema.BoolAttribute{Computed: true},
+						},
+					},
+				},
+				"action": schema.ListNestedAttribute{
+					Computed: true,
+					Description: "The actions the step takes, or the step interactions of an Android journey. " +
+						"The settings specific to the type of action, such as the element clicked, aren't included. " +
+						"Empty for COMMON steps, whose actions are those of the common step.",
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id":              schema.Int64Attribute{Computed: true},
+							"sequence":        schema.Int32Attribute{Computed: true},
+							"type":            schema.StringAttribute{Computed: true},
+							"description":     schema.StringAttribute{Computed: true},
+							"always_required": schema.BoolAttribute{Computed: true},
+							"wait_time":       schema.Int32Attribute{Computed: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	resp.Schema = schema.Schema{
-		Description: "Search for an individual Check. This will only allow a single result to be returned.",
+		Description: "Look up an individual Check by id, exact name or search, returning its configuration. " +
+			"The attributes specific to the type of check are returned in the block named after that type, with the others null.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "Text to search check names for, which must match a single check. Exactly one of search, id or name must be set.",
 			},
 			"id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The id of the check to look up.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The exact name of the check to look up, which must match a single check.",
+			},
+			"check_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of check, such as URL, DNS, PING, SOCKET, TLS_CERTIFICATE, WEB_JOURNEY or ANDROID_JOURNEY.",
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+			"maintenance_override": schema.BoolAttribute{
+				Computed: true,
+			},
+			"check_frequency": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The number of seconds between each run of the check.",
+			},
+			"trigger_count": schema.Int32Attribute{
+				Computed: true,
+			},
+			"result_retention": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The number of days results are kept for.",
+			},
+			"check_host_id": schema.Int32Attribute{
+				Computed: true,
+			},
+			"check_host_group_id": schema.Int32Attribute{
 				Computed: true,
 			},
+			"check_group_id": schema.Int32Attribute{
+				Computed: true,
+			},
+			"proxy_host_id": schema.Int32Attribute{
+				Computed: true,
+			},
+			"url_check": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Set for URL checks.",
+				Attributes: map[string]schema.Attribute{
+					"url":                    schema.StringAttribute{Computed: true},
+					"request_method":         schema.StringAttribute{Computed: true},
+					"expected_response_code": schema.Int32Attribute{Computed: true},
+					"warning_response_time":  schema.Int32Attribute{Computed: true},
+					"alert_response_time":    schema.Int32Attribute{Computed: true},
+					"timeout":                schema.Int32Attribute{Computed: true},
+					"allow_redirects":        schema.BoolAttribute{Computed: true},
+					"request_body":           schema.StringAttribute{Computed: true},
+					"request_header":         requestHeader,
+					"response_body_check":    responseBodyCheck,
+				},
+			},
+			"dns_check": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Set for DNS checks.",
+				Attributes: map[string]schema.Attribute{
+					"hostname":           schema.StringAttribute{Computed: true},
+					"expected_addresses": schema.ListAttribute{Computed: true, ElementType: types.StringType},
+				},
+			},
+			"ping_check": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Set for ping checks.",
+				Attributes: map[string]schema.Attribute{
+					"hostname":              schema.StringAttribute{Computed: true},
+					"timeout_time":          schema.Int32Attribute{Computed: true},
+					"warning_response_time": schema.Int32Attribute{Computed: true},
+				},
+			},
+			"socket_check": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Set for socket checks.",
+				Attributes: map[string]schema.Attribute{
+					"hostname": schema.StringAttribute{Computed: true},
+					"port":     schema.Int32Attribute{Computed: true},
+				},
+			},
+			"certificate_check": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Set for certificate checks.",
+				Attributes: map[string]schema.Attribute{
+					"url":                    schema.StringAttribute{Computed: true},
+					"warning_days_remaining": schema.Int32Attribute{Computed: true},
+					"alert_days_remaining":   schema.Int32Attribute{Computed: true},
+					"check_date_only":        schema.BoolAttribute{Computed: true},
+					"check_full_chain":       schema.BoolAttribute{Computed: true},
+				},
+			},
+			"web_journey_check": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Set for web journey checks.",
+				Attributes: map[string]schema.Attribute{
+					"start_url":     schema.StringAttribute{Computed: true},
+					"window_height": schema.Int32Attribute{Computed: true},
+					"window_width":  schema.Int32Attribute{Computed: true},
+					"monitor_domain": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"domain":              schema.StringAttribute{Computed: true},
+								"include_sub_domains": schema.BoolAttribute{Computed: true},
+							},
+						},
+					},
+					"step": step,
+				},
+			},
+			"android_journey_check": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Set for Android journey checks. The APK itself isn't returned.",
+				Attributes: map[string]schema.Attribute{
+					"apk_checksum":           schema.StringAttribute{Computed: true},
+					"screen_orientation":     schema.StringAttribute{Computed: true},
+					"override_package_name":  schema.StringAttribute{Computed: true},
+					"override_main_activity": schema.StringAttribute{Computed: true},
+					"step":                   step,
+				},
+			},
 		},
 	}
 }
 
+// ConfigValidators ensures the check is looked up in only one way.
+func (d *CheckDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("search"),
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *CheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericSingleDataSource64
+	var data CheckDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-
-	ids, err := d.client.SearchChecks(data.Search.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error searching check",
-			"Could not search check, unexpected error: "+err.Error(),
-		)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if len(ids) != 1 {
-		resp.Diagnostics.AddError(
-			"None or more than one matching check found",
-			"None or more than one matching check found when searching for single id",
-		)
+	if data.Id.IsNull() {
+		var ids []types.Int64
+		var err error
+
+		if !data.Name.IsNull() {
+			ids, err = d.client.FindChecksByName(data.Name.ValueString(), ctx)
+		} else {
+			ids, err = d.client.SearchChecks(data.Search.ValueString(), 2, ctx)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error searching check",
+				"Could not search check, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		if len(ids) != 1 {
+			resp.Diagnostics.AddError(
+				"None or more than one matching check found",
+				"None or more than one matching check found when searching for single id",
+			)
+			return
+		}
+
+		data.Id = ids[0]
+	}
+
+	check, err := d.client.GetCheck(data.Id.ValueInt64(), ctx)
+	if err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading check", "Could not read check", err)
 		return
 	}
 
-	data.Id = ids[0]
+	check.Search = data.Search
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, check)...)
 }
 
 func (r *CheckDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -44,7 +44,7 @@ func (d *CheckGroupDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchCheckGroups(data.Search.ValueString())
+	ids, err := d.client.SearchCheckGroups(data.Search.ValueString(), 2, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching check groups",
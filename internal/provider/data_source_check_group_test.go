@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccCheckGroupDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	ids := []int64{
+		server.Add(fakeepm.CheckGroups, map[string]any{"name": "Web Servers", "description": "Checks of the web servers.", "dashboardGroup": map[string]any{"id": 1}}),
+		server.Add(fakeepm.CheckGroups, map[string]any{"name": "Databases", "description": "Checks of the databases.", "dashboardGroup": map[string]any{"id": 1}}),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_group" "test" {
+  search = %q
+}
+`, "Web"),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_check_group.test", "id", fmt.Sprint(ids[0])),
+			},
+			{
+				// Only a single item may be found.
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_group" "test" {
+  search = %q
+}
+`, "Checks of"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_group" "test" {
+  search = %q
+}
+`, "Nothing by this name"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+		},
+	})
+}
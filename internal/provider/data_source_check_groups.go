@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,26 +29,63 @@ func (d *CheckGroupsDataSource) Metadata(ctx context.Context, req datasource.Met
 // Schema defines the schema for the data source.
 func (d *CheckGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Search for multiple Check Groups. A list of ids will be returned for all matches found.",
+		Description: "Search for multiple Check Groups. A list of ids, along with a summary of each, will be returned for all matches found. " +
+			"The search is made by EndPoint Monitor. The other filters are then applied by the provider, which reads every page of the search results, up to 1000 pages, until the limit is reached.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Description: "Text to search the names of check groups for. All check groups are searched when not set.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matches are returned when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression the name of each result must match.",
+				Optional:    true,
+			},
+			"dashboard_group_id": schema.Int32Attribute{
+				Description: "Only return check groups in this dashboard group.",
+				Optional:    true,
 			},
 			"ids": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.Int32Type,
 			},
+			"check_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A summary of each of the check groups found, in the same order as ids.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                 schema.Int32Attribute{Computed: true},
+						"name":               schema.StringAttribute{Computed: true},
+						"description":        schema.StringAttribute{Computed: true},
+						"dashboard_group_id": schema.Int32Attribute{Computed: true},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *CheckGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericMultipleDataSource
+	var data CheckGroupsDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchCheckGroups(data.Search.ValueString())
+	nameRegex := compileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkGroups, err := d.client.ListCheckGroups(data.Search.ValueString(), int(data.Limit.ValueInt64()), func(checkGroup CheckGroup) bool {
+		return matchesRegex(nameRegex, checkGroup.Name) &&
+			matchesId(data.DashboardGroupId, types.Int32Value(int32(checkGroup.DashboardGroup.Id)))
+	}, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching check groups",
@@ -55,7 +94,13 @@ func (d *CheckGroupsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	data.Ids = ids
+	data.Ids = []types.Int32{}
+	data.CheckGroups = []CheckGroupSummaryModel{}
+
+	for _, checkGroup := range checkGroups {
+		data.Ids = append(data.Ids, types.Int32Value(int32(checkGroup.Id)))
+		data.CheckGroups = append(data.CheckGroups, mapToCheckGroupSummaryModel(checkGroup))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
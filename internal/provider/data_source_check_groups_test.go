@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccCheckGroupsDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	operations := server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Operations", "description": "Operations dashboard."})
+	development := server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Development", "description": "Development dashboard."})
+
+	web := server.Add(fakeepm.CheckGroups, map[string]any{"name": "Web Servers", "description": "Checks of the web servers.", "dashboardGroup": map[string]any{"id": operations}})
+	databases := server.Add(fakeepm.CheckGroups, map[string]any{"name": "Databases", "description": "Checks of the databases.", "dashboardGroup": map[string]any{"id": operations}})
+	server.Add(fakeepm.CheckGroups, map[string]any{"name": "Web Servers (Test)", "description": "Checks of the test web servers.", "dashboardGroup": map[string]any{"id": development}})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_groups" "all" {}
+
+data "endpointmonitor_check_groups" "search" {
+  search = "web"
+}
+
+data "endpointmonitor_check_groups" "operations" {
+  dashboard_group_id = %d
+}
+
+data "endpointmonitor_check_groups" "regex" {
+  name_regex = "^Web Servers$"
+}
+
+data "endpointmonitor_check_groups" "limit" {
+  limit = 1
+}
+`, operations),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.operations", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.operations", "ids.1", fmt.Sprint(databases)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.regex", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.regex", "check_groups.0.id", fmt.Sprint(web)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.regex", "check_groups.0.name", "Web Servers"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.regex", "check_groups.0.description", "Checks of the web servers."),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.regex", "check_groups.0.dashboard_group_id", fmt.Sprint(operations)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_groups.limit", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
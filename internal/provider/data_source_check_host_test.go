@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccCheckHostDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	ids := []int64{
+		server.Add(fakeepm.CheckHosts, map[string]any{"hostname": "agent-01.mycompany.com", "description": "Agent.", "type": "AGENT", "enabled": true}),
+		server.Add(fakeepm.CheckHosts, map[string]any{"hostname": "android-01.mycompany.com", "description": "Android host.", "type": "ANDROID", "enabled": true}),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_host" "test" {
+  search = %q
+}
+`, "android"),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_check_host.test", "id", fmt.Sprint(ids[1])),
+			},
+			{
+				// Only a single item may be found.
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_host" "test" {
+  search = %q
+}
+`, "mycompany"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_host" "test" {
+  search = %q
+}
+`, "Nothing by this name"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+		},
+	})
+}
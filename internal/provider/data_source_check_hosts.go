@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,26 +29,69 @@ func (d *CheckHostsDataSource) Metadata(ctx context.Context, req datasource.Meta
 // Schema defines the schema for the data source.
 func (d *CheckHostsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Search for multiple Check Hosts. A list of ids will be returned for all matches found.",
+		Description: "Search for multiple Check Hosts. A list of ids, along with a summary of each, will be returned for all matches found. " +
+			"The search is made by EndPoint Monitor. The other filters are then applied by the provider, which reads every page of the search results, up to 1000 pages, until the limit is reached.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Description: "Text to search the hostnames of check hosts for. All check hosts are searched when not set.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matches are returned when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression the hostname of each result must match.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return check hosts of this type, such as AGENT or ANDROID.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only return check hosts that are enabled, when true, or disabled, when false.",
+				Optional:    true,
 			},
 			"ids": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.Int32Type,
 			},
+			"check_hosts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A summary of each of the check hosts found, in the same order as ids.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.Int32Attribute{Computed: true},
+						"hostname":    schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+						"type":        schema.StringAttribute{Computed: true},
+						"enabled":     schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *CheckHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericMultipleDataSource
+	var data CheckHostsDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchCheckHosts(data.Search.ValueString())
+	nameRegex := compileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkHosts, err := d.client.ListCheckHosts(data.Search.ValueString(), int(data.Limit.ValueInt64()), func(checkHost CheckHost) bool {
+		return matchesRegex(nameRegex, checkHost.Hostname) &&
+			(data.Type.IsNull() || checkHost.Type != nil && matchesString(data.Type, *checkHost.Type)) &&
+			matchesBool(data.Enabled, checkHost.Enabled)
+	}, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching check hosts",
@@ -55,7 +100,13 @@ func (d *CheckHostsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	data.Ids = ids
+	data.Ids = []types.Int32{}
+	data.CheckHosts = []CheckHostSummaryModel{}
+
+	for _, checkHost := range checkHosts {
+		data.Ids = append(data.Ids, types.Int32Value(int32(checkHost.Id)))
+		data.CheckHosts = append(data.CheckHosts, mapToCheckHostSummaryModel(checkHost))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccCheckHostsDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	server.Add(fakeepm.CheckHosts, map[string]any{"hostname": "agent-01.mycompany.com", "description": "Agent.", "type": "AGENT", "enabled": true})
+	server.Add(fakeepm.CheckHosts, map[string]any{"hostname": "agent-02.mycompany.com", "description": "Retired agent.", "type": "AGENT", "enabled": false})
+	android := server.Add(fakeepm.CheckHosts, map[string]any{"hostname": "android-01.mycompany.com", "description": "Android host.", "type": "ANDROID", "enabled": true})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_hosts" "search" {
+  search = "agent"
+}
+
+data "endpointmonitor_check_hosts" "enabled" {
+  enabled = true
+}
+
+data "endpointmonitor_check_hosts" "android" {
+  type = "ANDROID"
+}
+
+data "endpointmonitor_check_hosts" "regex" {
+  name_regex = "-0[12]\\."
+  enabled    = false
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.enabled", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.android", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.android", "check_hosts.0.id", fmt.Sprint(android)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.android", "check_hosts.0.hostname", "android-01.mycompany.com"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.android", "check_hosts.0.type", "ANDROID"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.android", "check_hosts.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.regex", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_hosts.regex", "check_hosts.0.hostname", "agent-02.mycompany.com"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCheckDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	checks := testAccCheckDependencies + `
+resource "endpointmonitor_url_check" "test" {
+  name                   = "Test URL Check"
+  description            = "Test URL check."
+  check_frequency        = 60
+  url                    = "https://www.mycompany.com/"
+  request_method         = "GET"
+  expected_response_code = 200
+  timeout                = 10000
+  alert_response_time    = 5000
+  warning_response_time  = 3000
+  trigger_count          = 3
+  check_host_id          = endpointmonitor_check_host.test.id
+  check_group_id         = endpointmonitor_check_group.test.id
+}
+
+resource "endpointmonitor_dns_check" "test" {
+  name               = "Test DNS Check"
+  description        = "Test DNS check."
+  check_frequency    = 300
+  hostname           = "one.one.one.one"
+  expected_addresses = ["1.0.0.1", "1.1.1.1"]
+  trigger_count      = 2
+  check_host_id      = endpointmonitor_check_host.test.id
+  check_group_id     = endpointmonitor_check_group.test.id
+}
+
+resource "endpointmonitor_web_journey_check" "test" {
+  name            = "Test Web Journey Check"
+  description     = "Test web journey check."
+  check_frequency = 120
+  start_url       = "https://www.mycompany.com/login"
+  trigger_count   = 3
+
+  step {
+    sequence  = 1
+    name      = "Login"
+    type      = "CUSTOM"
+    wait_time = 5000
+
+    page_check {
+      description = "Check login form is shown."
+      type        = "CHECK_FOR_TEXT"
+
+      check_for_text {
+        text_to_find = "Login"
+        state        = "PRESENT"
+      }
+    }
+
+    action {
+      sequence        = 1
+      description     = "Enter username"
+      always_required = true
+      type            = "TEXT_INPUT"
+
+      text_input {
+        element_id = "login_username"
+        input_text = "my.user@mycompany.com"
+      }
+    }
+  }
+
+  check_host_id  = endpointmonitor_check_host.test.id
+  check_group_id = endpointmonitor_check_group.test.id
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, checks),
+			},
+			{
+				Config: testAccConfig(server, checks+`
+data "endpointmonitor_check" "search" {
+  search = "Test URL"
+}
+
+data "endpointmonitor_check" "name" {
+  name = "Test DNS Check"
+}
+
+data "endpointmonitor_check" "id" {
+  id = endpointmonitor_web_journey_check.test.id
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.endpointmonitor_check.search", "id", "endpointmonitor_url_check.test", "id"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.search", "check_type", "URL"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.search", "url_check.url", "https://www.mycompany.com/"),
+					resource.TestCheckResourceAttrPair("data.endpointmonitor_check.search", "check_host_id", "endpointmonitor_check_host.test", "id"),
+					resource.TestCheckResourceAttrPair("data.endpointmonitor_check.name", "id", "endpointmonitor_dns_check.test", "id"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.name", "check_type", "DNS"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.name", "dns_check.hostname", "one.one.one.one"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.name", "dns_check.expected_addresses.#", "2"),
+					resource.TestCheckNoResourceAttr("data.endpointmonitor_check.name", "url_check"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "name", "Test Web Journey Check"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.start_url", "https://www.mycompany.com/login"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.step.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.step.0.name", "Login"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.step.0.page_check.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.step.0.page_check.0.type", "CHECK_FOR_TEXT"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.step.0.page_check.0.description", "Check login form is shown."),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.step.0.action.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.step.0.action.0.type", "TEXT_INPUT"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check.id", "web_journey_check.step.0.action.0.always_required", "true"),
+				),
+			},
+			{
+				// Both the URL and DNS checks are found.
+				Config: testAccConfig(server, checks+`
+data "endpointmonitor_check" "search" {
+  search = "Test"
+}
+`),
+				ExpectError: regexp.MustCompile("None or more than one matching check found"),
+			},
+		},
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,26 +29,97 @@ func (d *ChecksDataSource) Metadata(ctx context.Context, req datasource.Metadata
 // Schema defines the schema for the data source.
 func (d *ChecksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Search for multiple Checks. A list of ids will be returned for all matches found.",
+		Description: "Search for multiple Checks. A list of ids, along with a summary of each, will be returned for all matches found. " +
+			"The search is made by EndPoint Monitor. The other filters are then applied by the provider, which reads every page of the search results, up to 1000 pages, until the limit is reached.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Description: "Text to search the names of checks for. All checks are searched when not set.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matches are returned when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression the name of each result must match.",
+				Optional:    true,
+			},
+			"check_type": schema.StringAttribute{
+				Description: "Only return checks of this type, such as URL, DNS, PING, SOCKET, TLS_CERTIFICATE, WEB_JOURNEY or ANDROID_JOURNEY.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only return checks that are enabled, when true, or disabled, when false.",
+				Optional:    true,
+			},
+			"check_group_id": schema.Int32Attribute{
+				Description: "Only return checks in this check group.",
+				Optional:    true,
+			},
+			"dashboard_group_id": schema.Int32Attribute{
+				Description: "Only return checks in a check group on this dashboard group.",
+				Optional:    true,
+			},
+			"check_host_id": schema.Int32Attribute{
+				Description: "Only return checks that run on this check host.",
+				Optional:    true,
+			},
+			"check_host_group_id": schema.Int32Attribute{
+				Description: "Only return checks that run on this check host group.",
+				Optional:    true,
 			},
 			"ids": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.Int64Type,
 			},
+			"checks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A summary of each of the checks found, in the same order as ids.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                  schema.Int64Attribute{Computed: true},
+						"name":                schema.StringAttribute{Computed: true},
+						"description":         schema.StringAttribute{Computed: true},
+						"check_type":          schema.StringAttribute{Computed: true},
+						"enabled":             schema.BoolAttribute{Computed: true},
+						"check_frequency":     schema.Int32Attribute{Computed: true},
+						"check_group_id":      schema.Int32Attribute{Computed: true},
+						"dashboard_group_id":  schema.Int32Attribute{Computed: true, Description: "The dashboard group of the check's check group."},
+						"check_host_id":       schema.Int32Attribute{Computed: true},
+						"check_host_group_id": schema.Int32Attribute{Computed: true},
+						"proxy_host_id":       schema.Int32Attribute{Computed: true},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *ChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericMultipleDataSource64
+	var data ChecksDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchChecks(data.Search.ValueString())
+	nameRegex := compileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checks, err := d.client.ListChecks(data.Search.ValueString(), int(data.Limit.ValueInt64()), func(check Check) bool {
+		summary := mapToCheckSummaryModel(check)
+
+		return matchesRegex(nameRegex, check.Name) &&
+			matchesString(data.CheckType, check.CheckType) &&
+			matchesBool(data.Enabled, check.Enabled) &&
+			matchesId(data.CheckGroupId, summary.CheckGroupId) &&
+			matchesId(data.DashboardGroupId, summary.DashboardGroupId) &&
+			matchesId(data.CheckHostId, summary.CheckHostId) &&
+			matchesId(data.HostGroupId, summary.HostGroupId)
+	}, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching checks",
@@ -55,7 +128,7 @@ func (d *ChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	if len(ids) < 1 {
+	if len(checks) < 1 {
 		resp.Diagnostics.AddError(
 			"No matching checks found",
 			"No matching checks found when searching for multiple checks",
@@ -63,7 +136,13 @@ func (d *ChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	data.Ids = ids
+	data.Ids = []types.Int64{}
+	data.Checks = []CheckSummaryModel{}
+
+	for _, check := range checks {
+		data.Ids = append(data.Ids, types.Int64Value(check.Id))
+		data.Checks = append(data.Checks, mapToCheckSummaryModel(check))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
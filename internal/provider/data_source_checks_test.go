@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccChecksDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	agent := map[string]any{"id": 1}
+	androidHosts := map[string]any{"id": 2}
+	web := map[string]any{"id": 3, "dashboardGroup": map[string]any{"id": 4}}
+	proxy := map[string]any{"id": 5}
+
+	urlCheck := server.Add(fakeepm.Checks, map[string]any{"name": "Home Page", "description": "Home page is up.", "checkType": "URL", "enabled": true,
+		"checkFrequency": 60, "checkHost": agent, "checkGroup": web})
+	dnsCheck := server.Add(fakeepm.Checks, map[string]any{"name": "Home Page DNS", "description": "Home page resolves.", "checkType": "DNS", "enabled": false,
+		"checkFrequency": 300, "checkHost": agent, "checkGroup": web})
+	androidCheck := server.Add(fakeepm.Checks, map[string]any{"name": "App Login", "description": "App login works.", "checkType": "ANDROID_JOURNEY", "enabled": true,
+		"checkFrequency": 120, "hostGroup": androidHosts, "proxyHost": proxy})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_checks" "search" {
+  search = "home page"
+}
+
+data "endpointmonitor_checks" "on_agent" {
+  check_host_id = 1
+  name_regex    = "DNS$"
+}
+
+data "endpointmonitor_checks" "enabled" {
+  enabled = true
+}
+
+data "endpointmonitor_checks" "group" {
+  check_group_id = 3
+  check_type     = "URL"
+}
+
+data "endpointmonitor_checks" "dashboard" {
+  dashboard_group_id = 4
+}
+
+data "endpointmonitor_checks" "android" {
+  check_type          = "ANDROID_JOURNEY"
+  check_host_group_id = 2
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.on_agent", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.on_agent", "ids.0", fmt.Sprint(dnsCheck)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.on_agent", "checks.0.enabled", "false"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.enabled", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.group", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.group", "checks.0.id", fmt.Sprint(urlCheck)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.group", "checks.0.name", "Home Page"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.group", "checks.0.check_type", "URL"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.group", "checks.0.check_frequency", "60"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.group", "checks.0.check_host_id", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.group", "checks.0.dashboard_group_id", "4"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.dashboard", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.dashboard", "ids.0", fmt.Sprint(urlCheck)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.dashboard", "ids.1", fmt.Sprint(dnsCheck)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.android", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.android", "ids.0", fmt.Sprint(androidCheck)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_checks.android", "checks.0.proxy_host_id", "5"),
+					resource.TestCheckNoResourceAttr("data.endpointmonitor_checks.android", "checks.0.check_group_id"),
+					resource.TestCheckNoResourceAttr("data.endpointmonitor_checks.android", "checks.0.dashboard_group_id"),
+				),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_checks" "none" {
+  check_type = "PING"
+}
+`),
+				ExpectError: regexp.MustCompile("No matching checks found"),
+			},
+		},
+	})
+}
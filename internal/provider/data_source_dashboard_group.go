@@ -44,7 +44,7 @@ func (d *DashboardGroupDataSource) Read(ctx context.Context, req datasource.Read
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchDashboardGroups(data.Search.ValueString())
+	ids, err := d.client.SearchDashboardGroups(data.Search.ValueString(), 2, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching dashboard groups",
@@ -53,6 +53,14 @@ func (d *DashboardGroupDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	if len(ids) != 1 {
+		resp.Diagnostics.AddError(
+			"None or more than one matching dashboard group found",
+			"None or more than one matching dashboard group found when searching for single id",
+		)
+		return
+	}
+
 	data.Id = ids[0]
 
 	// Save data into Terraform state
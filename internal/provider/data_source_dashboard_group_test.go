@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccDashboardGroupDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	ids := []int64{
+		server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Operations", "description": "Operations dashboard."}),
+		server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Development", "description": "Development dashboard."}),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_dashboard_group" "test" {
+  search = %q
+}
+`, "Operations"),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_dashboard_group.test", "id", fmt.Sprint(ids[0])),
+			},
+			{
+				// Only a single item may be found.
+				Config: testAccConfig(server, `
+data "endpointmonitor_dashboard_group" "test" {
+  search = %q
+}
+`, "dashboard"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_dashboard_group" "test" {
+  search = %q
+}
+`, "Nothing by this name"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+		},
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,35 +29,66 @@ func (d *DashboardGroupsDataSource) Metadata(ctx context.Context, req datasource
 // Schema defines the schema for the data source.
 func (d *DashboardGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Search for multiple Dashboard Groups. A list of ids will be returned for all matches found.",
+		Description: "Search for multiple Dashboard Groups. A list of ids, along with a summary of each, will be returned for all matches found. " +
+			"The search is made by EndPoint Monitor. The other filters are then applied by the provider, which reads every page of the search results, up to 1000 pages, until the limit is reached.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Description: "Text to search the names of dashboard groups for. All dashboard groups are searched when not set.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matches are returned when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression the name of each result must match.",
+				Optional:    true,
 			},
 			"ids": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.Int32Type,
 			},
+			"dashboard_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A summary of each of the dashboard groups found, in the same order as ids.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.Int32Attribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *DashboardGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericMultipleDataSource
+	var data DashboardGroupsDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchDashboardGroups(data.Search.ValueString())
+	nameRegex := compileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboardGroups, err := d.client.ListDashboardGroups(data.Search.ValueString(), int(data.Limit.ValueInt64()), func(dashboardGroup DashboardGroup) bool {
+		return matchesRegex(nameRegex, dashboardGroup.Name)
+	}, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error searching dsahboard groups",
+			"Error searching dashboard groups",
 			"Could not search dashboard groups, unexpected error: "+err.Error(),
 		)
 		return
 	}
 
-	if len(ids) < 1 {
+	if len(dashboardGroups) < 1 {
 		resp.Diagnostics.AddError(
 			"No matching dashboard groups found",
 			"No matching dashboard groups found in EPM",
@@ -63,7 +96,13 @@ func (d *DashboardGroupsDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	data.Ids = ids
+	data.Ids = []types.Int32{}
+	data.DashboardGroups = []NamedItemSummaryModel{}
+
+	for _, dashboardGroup := range dashboardGroups {
+		data.Ids = append(data.Ids, types.Int32Value(int32(dashboardGroup.Id)))
+		data.DashboardGroups = append(data.DashboardGroups, mapToDashboardGroupSummaryModel(dashboardGroup))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
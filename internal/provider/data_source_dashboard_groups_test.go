@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccDashboardGroupsDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	operations := server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Operations", "description": "Operations dashboard."})
+	server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Development", "description": "Development dashboard."})
+	server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Operations (Test)", "description": "Test operations dashboard."})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_dashboard_groups" "search" {
+  search = "operations"
+}
+
+data "endpointmonitor_dashboard_groups" "regex" {
+  name_regex = "^Operations$"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.endpointmonitor_dashboard_groups.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_dashboard_groups.regex", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_dashboard_groups.regex", "dashboard_groups.0.id", fmt.Sprint(operations)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_dashboard_groups.regex", "dashboard_groups.0.name", "Operations"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_dashboard_groups.regex", "dashboard_groups.0.description", "Operations dashboard."),
+				),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_dashboard_groups" "none" {
+  search = "Nothing by this name"
+}
+`),
+				ExpectError: regexp.MustCompile("No matching dashboard groups found"),
+			},
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccHostGroupDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	ids := []int64{
+		server.Add(fakeepm.HostGroups, map[string]any{"name": "Android Devices", "description": "Android devices.", "enabled": true, "checkHosts": []any{}}),
+		server.Add(fakeepm.HostGroups, map[string]any{"name": "Agents", "description": "Agents.", "enabled": true, "checkHosts": []any{}}),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_host_group" "test" {
+  search = %q
+}
+`, "Agents"),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_check_host_group.test", "id", fmt.Sprint(ids[1])),
+			},
+			{
+				// Only a single item may be found.
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_host_group" "test" {
+  search = %q
+}
+`, "A"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_host_group" "test" {
+  search = %q
+}
+`, "Nothing by this name"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+		},
+	})
+}
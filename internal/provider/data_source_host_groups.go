@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,26 +29,71 @@ func (d *HostGroupsDataSource) Metadata(ctx context.Context, req datasource.Meta
 // Schema defines the schema for the data source.
 func (d *HostGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Search for multiple Check Host Groups. A list of ids will be returned for all matches found.",
+		Description: "Search for multiple Check Host Groups. A list of ids, along with a summary of each, will be returned for all matches found. " +
+			"The search is made by EndPoint Monitor. The other filters are then applied by the provider, which reads every page of the search results, up to 1000 pages, until the limit is reached.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Description: "Text to search the names of check host groups for. All check host groups are searched when not set.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matches are returned when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression the name of each result must match.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only return check host groups that are enabled, when true, or disabled, when false.",
+				Optional:    true,
+			},
+			"check_host_id": schema.Int32Attribute{
+				Description: "Only return check host groups that include this check host.",
+				Optional:    true,
 			},
 			"ids": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.Int32Type,
 			},
+			"host_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A summary of each of the check host groups found, in the same order as ids.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":             schema.Int32Attribute{Computed: true},
+						"name":           schema.StringAttribute{Computed: true},
+						"description":    schema.StringAttribute{Computed: true},
+						"enabled":        schema.BoolAttribute{Computed: true},
+						"check_host_ids": schema.ListAttribute{Computed: true, ElementType: types.Int32Type},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *HostGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericMultipleDataSource
+	var data HostGroupsDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchHostGroups(data.Search.ValueString())
+	nameRegex := compileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostGroups, err := d.client.ListHostGroups(data.Search.ValueString(), int(data.Limit.ValueInt64()), func(hostGroup HostGroup) bool {
+		summary := mapToHostGroupSummaryModel(hostGroup)
+
+		return matchesRegex(nameRegex, hostGroup.Name) &&
+			matchesBool(data.Enabled, hostGroup.Enabled) &&
+			containsId(data.CheckHostId, summary.Hosts)
+	}, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching host groups",
@@ -55,7 +102,13 @@ func (d *HostGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	data.Ids = ids
+	data.Ids = []types.Int32{}
+	data.HostGroups = []HostGroupSummaryModel{}
+
+	for _, hostGroup := range hostGroups {
+		data.Ids = append(data.Ids, types.Int32Value(int32(hostGroup.Id)))
+		data.HostGroups = append(data.HostGroups, mapToHostGroupSummaryModel(hostGroup))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
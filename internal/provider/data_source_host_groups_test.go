@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccHostGroupsDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	first := server.Add(fakeepm.CheckHosts, map[string]any{"hostname": "android-01.mycompany.com", "type": "ANDROID", "enabled": true})
+	second := server.Add(fakeepm.CheckHosts, map[string]any{"hostname": "android-02.mycompany.com", "type": "ANDROID", "enabled": true})
+
+	devices := server.Add(fakeepm.HostGroups, map[string]any{"name": "Android Devices", "description": "Android devices.", "enabled": true,
+		"checkHosts": []any{map[string]any{"id": first}, map[string]any{"id": second}}})
+	server.Add(fakeepm.HostGroups, map[string]any{"name": "Android Test Devices", "description": "Android test devices.", "enabled": false,
+		"checkHosts": []any{map[string]any{"id": second}}})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_check_host_groups" "search" {
+  search = "android"
+}
+
+data "endpointmonitor_check_host_groups" "enabled" {
+  enabled = true
+}
+
+data "endpointmonitor_check_host_groups" "first" {
+  check_host_id = %d
+}
+
+data "endpointmonitor_check_host_groups" "regex" {
+  name_regex = "Test"
+}
+`, first),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_host_groups.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_host_groups.enabled", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_host_groups.first", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_host_groups.first", "host_groups.0.id", fmt.Sprint(devices)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_host_groups.first", "host_groups.0.name", "Android Devices"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_host_groups.first", "host_groups.0.check_host_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_host_groups.regex", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_check_host_groups.regex", "host_groups.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
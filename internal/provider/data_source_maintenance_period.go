@@ -44,7 +44,7 @@ func (d *MaintenancePeriodDataSource) Read(ctx context.Context, req datasource.R
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchMaintenancePeriods(data.Search.ValueString())
+	ids, err := d.client.SearchMaintenancePeriods(data.Search.ValueString(), 2, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching maintenance periods",
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccMaintenancePeriodDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	ids := []int64{
+		server.Add(fakeepm.MaintenancePeriods, map[string]any{"description": "Nightly backups.", "enabled": true, "dayOfWeek": "ALL", "startTime": "01:00", "endTime": "03:00", "checks": []any{}, "checkGroups": []any{}, "dashboardGroups": []any{}}),
+		server.Add(fakeepm.MaintenancePeriods, map[string]any{"description": "Weekly patching.", "enabled": true, "dayOfWeek": "SUNDAY", "startTime": "02:00", "endTime": "06:00", "checks": []any{}, "checkGroups": []any{}, "dashboardGroups": []any{}}),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_maintenance_period" "test" {
+  search = %q
+}
+`, "patching"),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_period.test", "id", fmt.Sprint(ids[1])),
+			},
+			{
+				// Only a single item may be found.
+				Config: testAccConfig(server, `
+data "endpointmonitor_maintenance_period" "test" {
+  search = %q
+}
+`, "ly"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_maintenance_period" "test" {
+  search = %q
+}
+`, "Nothing by this name"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+		},
+	})
+}
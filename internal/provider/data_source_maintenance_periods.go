@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,26 +29,78 @@ func (d *MaintenancePeriodsDataSource) Metadata(ctx context.Context, req datasou
 // Schema defines the schema for the data source.
 func (d *MaintenancePeriodsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Search for multiple Scheduled Maintenance Periods. A list of ids will be returned for all matches found.",
+		Description: "Search for multiple Scheduled Maintenance Periods. A list of ids, along with a summary of each, will be returned for all matches found. " +
+			"The search is made by EndPoint Monitor. The other filters are then applied by the provider, which reads every page of the search results, up to 1000 pages, until the limit is reached.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Description: "Text to search the descriptions of maintenance periods for. All maintenance periods are searched when not set.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matches are returned when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only return maintenance periods that are enabled, when true, or disabled, when false.",
+				Optional:    true,
+			},
+			"check_id": schema.Int32Attribute{
+				Description: "Only return maintenance periods that include this check.",
+				Optional:    true,
+			},
+			"check_group_id": schema.Int32Attribute{
+				Description: "Only return maintenance periods that include this check group.",
+				Optional:    true,
+			},
+			"dashboard_group_id": schema.Int32Attribute{
+				Description: "Only return maintenance periods that include this dashboard group.",
+				Optional:    true,
 			},
 			"ids": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.Int32Type,
 			},
+			"maintenance_periods": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A summary of each of the maintenance periods found, in the same order as ids.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                  schema.Int32Attribute{Computed: true},
+						"description":         schema.StringAttribute{Computed: true},
+						"enabled":             schema.BoolAttribute{Computed: true},
+						"start_time":          schema.StringAttribute{Computed: true},
+						"end_time":            schema.StringAttribute{Computed: true},
+						"day_of_week":         schema.StringAttribute{Computed: true},
+						"check_ids":           schema.ListAttribute{Computed: true, ElementType: types.Int32Type},
+						"check_group_ids":     schema.ListAttribute{Computed: true, ElementType: types.Int32Type},
+						"dashboard_group_ids": schema.ListAttribute{Computed: true, ElementType: types.Int32Type},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *MaintenancePeriodsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericMultipleDataSource
+	var data MaintenancePeriodsDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	ids, err := d.client.SearchMaintenancePeriods(data.Search.ValueString())
+	maintenancePeriods, err := d.client.ListMaintenancePeriods(data.Search.ValueString(), int(data.Limit.ValueInt64()), func(maintenancePeriod MaintenancePeriod) bool {
+		summary := mapToMaintenancePeriodSummaryModel(maintenancePeriod)
+
+		return matchesBool(data.Enabled, maintenancePeriod.Enabled) &&
+			containsId(data.CheckId, summary.Checks) &&
+			containsId(data.CheckGroupId, summary.CheckGroups) &&
+			containsId(data.DashboardGroupId, summary.DashboardGroups)
+	}, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching maintenance periods",
@@ -55,7 +109,13 @@ func (d *MaintenancePeriodsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	data.Ids = ids
+	data.Ids = []types.Int32{}
+	data.MaintenancePeriods = []MaintenancePeriodSummaryModel{}
+
+	for _, maintenancePeriod := range maintenancePeriods {
+		data.Ids = append(data.Ids, types.Int32Value(int32(maintenancePeriod.Id)))
+		data.MaintenancePeriods = append(data.MaintenancePeriods, mapToMaintenancePeriodSummaryModel(maintenancePeriod))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
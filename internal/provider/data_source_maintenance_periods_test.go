@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccMaintenancePeriodsDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	backups := server.Add(fakeepm.MaintenancePeriods, map[string]any{"description": "Nightly backups.", "enabled": true, "dayOfWeek": "ALL",
+		"startTime": "01:00", "endTime": "03:00", "checks": []any{1, 2}, "checkGroups": []any{}, "dashboardGroups": []any{}})
+	server.Add(fakeepm.MaintenancePeriods, map[string]any{"description": "Weekly patching.", "enabled": true, "dayOfWeek": "SUNDAY",
+		"startTime": "02:00", "endTime": "06:00", "checks": []any{}, "checkGroups": []any{3}, "dashboardGroups": []any{}})
+	server.Add(fakeepm.MaintenancePeriods, map[string]any{"description": "Monthly patching.", "enabled": false, "dayOfWeek": "SATURDAY",
+		"startTime": "02:00", "endTime": "06:00", "checks": []any{}, "checkGroups": []any{}, "dashboardGroups": []any{4}})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_maintenance_periods" "search" {
+  search = "patching"
+}
+
+data "endpointmonitor_maintenance_periods" "enabled" {
+  enabled = true
+}
+
+data "endpointmonitor_maintenance_periods" "check" {
+  check_id = 2
+}
+
+data "endpointmonitor_maintenance_periods" "check_group" {
+  check_group_id = 3
+}
+
+data "endpointmonitor_maintenance_periods" "dashboard_group" {
+  dashboard_group_id = 4
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.enabled", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.check", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.check", "maintenance_periods.0.id", fmt.Sprint(backups)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.check", "maintenance_periods.0.day_of_week", "ALL"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.check", "maintenance_periods.0.start_time", "01:00"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.check", "maintenance_periods.0.check_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.check_group", "maintenance_periods.0.description", "Weekly patching."),
+					resource.TestCheckResourceAttr("data.endpointmonitor_maintenance_periods.dashboard_group", "maintenance_periods.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccProxyHostDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	ids := []int64{
+		server.Add(fakeepm.ProxyHosts, map[string]any{"name": "Office Proxy", "description": "Office proxy.", "hostname": "proxy-01.mycompany.com", "port": 3128}),
+		server.Add(fakeepm.ProxyHosts, map[string]any{"name": "Data Centre Proxy", "description": "Data centre proxy.", "hostname": "proxy-02.mycompany.com", "port": 8080}),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_proxy_host" "test" {
+  search = %q
+}
+`, "Office"),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_proxy_host.test", "id", fmt.Sprint(ids[0])),
+			},
+			{
+				// Only a single item may be found.
+				Config: testAccConfig(server, `
+data "endpointmonitor_proxy_host" "test" {
+  search = %q
+}
+`, "Proxy"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_proxy_host" "test" {
+  search = %q
+}
+`, "Nothing by this name"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+		},
+	})
+}
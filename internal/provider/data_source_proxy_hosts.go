@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,26 +29,59 @@ func (d *ProxyHostsDataSource) Metadata(ctx context.Context, req datasource.Meta
 // Schema defines the schema for the data source.
 func (d *ProxyHostsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Search for multiple Proxy Hosts. A list of ids will be returned for all matches found.",
+		Description: "Search for multiple Proxy Hosts. A list of ids, along with a summary of each, will be returned for all matches found. " +
+			"The search is made by EndPoint Monitor. The other filters are then applied by the provider, which reads every page of the search results, up to 1000 pages, until the limit is reached.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Description: "Text to search the names of proxy hosts for. All proxy hosts are searched when not set.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matches are returned when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression the name of each result must match.",
+				Optional:    true,
 			},
 			"ids": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.Int32Type,
 			},
+			"proxy_hosts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A summary of each of the proxy hosts found, in the same order as ids.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.Int32Attribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+						"hostname":    schema.StringAttribute{Computed: true},
+						"port":        schema.Int32Attribute{Computed: true},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *ProxyHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericMultipleDataSource
+	var data ProxyHostsDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchProxyHosts(data.Search.ValueString())
+	nameRegex := compileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	proxyHosts, err := d.client.ListProxyHosts(data.Search.ValueString(), int(data.Limit.ValueInt64()), func(proxyHost ProxyHost) bool {
+		return matchesRegex(nameRegex, proxyHost.Name)
+	}, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching proxy hosts",
@@ -55,7 +90,13 @@ func (d *ProxyHostsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	data.Ids = ids
+	data.Ids = []types.Int32{}
+	data.ProxyHosts = []ProxyHostSummaryModel{}
+
+	for _, proxyHost := range proxyHosts {
+		data.Ids = append(data.Ids, types.Int32Value(int32(proxyHost.Id)))
+		data.ProxyHosts = append(data.ProxyHosts, mapToProxyHostSummaryModel(proxyHost))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccProxyHostsDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	office := server.Add(fakeepm.ProxyHosts, map[string]any{"name": "Office Proxy", "description": "Office proxy.", "hostname": "proxy-01.mycompany.com", "port": 3128})
+	server.Add(fakeepm.ProxyHosts, map[string]any{"name": "Data Centre Proxy", "description": "Data centre proxy.", "hostname": "proxy-02.mycompany.com", "port": 8080})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_proxy_hosts" "search" {
+  search = "proxy"
+}
+
+data "endpointmonitor_proxy_hosts" "regex" {
+  name_regex = "^Office"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.endpointmonitor_proxy_hosts.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_proxy_hosts.regex", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_proxy_hosts.regex", "proxy_hosts.0.id", fmt.Sprint(office)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_proxy_hosts.regex", "proxy_hosts.0.hostname", "proxy-01.mycompany.com"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_proxy_hosts.regex", "proxy_hosts.0.port", "3128"),
+				),
+			},
+		},
+	})
+}
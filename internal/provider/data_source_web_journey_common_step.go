@@ -44,7 +44,7 @@ func (d *WebJourneyCommonStepDataSource) Read(ctx context.Context, req datasourc
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchWebJoureyCommonSteps(data.Search.ValueString())
+	ids, err := d.client.SearchWebJoureyCommonSteps(data.Search.ValueString(), 2, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching web journey common steps",
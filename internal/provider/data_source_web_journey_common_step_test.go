@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccWebJourneyCommonStepDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	ids := []int64{
+		server.Add(fakeepm.WebJourneyCommonSteps, map[string]any{"name": "Accept Cookies", "description": "Accepts the cookie banner.", "waitTime": 5000, "warningPageLoadTime": 2500, "alertPageLoadTime": 5000}),
+		server.Add(fakeepm.WebJourneyCommonSteps, map[string]any{"name": "Log In", "description": "Logs in.", "waitTime": 5000, "warningPageLoadTime": 2500, "alertPageLoadTime": 5000}),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_web_journey_common_step" "test" {
+  search = %q
+}
+`, "Log In"),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_web_journey_common_step.test", "id", fmt.Sprint(ids[1])),
+			},
+			{
+				// Only a single item may be found.
+				Config: testAccConfig(server, `
+data "endpointmonitor_web_journey_common_step" "test" {
+  search = %q
+}
+`, "s"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_web_journey_common_step" "test" {
+  search = %q
+}
+`, "Nothing by this name"),
+				ExpectError: regexp.MustCompile("None or more than one"),
+			},
+		},
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,26 +29,57 @@ func (d *WebJourneyCommonStepsDataSource) Metadata(ctx context.Context, req data
 // Schema defines the schema for the data source.
 func (d *WebJourneyCommonStepsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Search for multiple Common Web Journey Steps. A list of ids will be returned for all matches found.",
+		Description: "Search for multiple Common Web Journey Steps. A list of ids, along with a summary of each, will be returned for all matches found. " +
+			"The search is made by EndPoint Monitor. The other filters are then applied by the provider, which reads every page of the search results, up to 1000 pages, until the limit is reached.",
 		Attributes: map[string]schema.Attribute{
 			"search": schema.StringAttribute{
-				Required: true,
+				Description: "Text to search the names of common steps for. All common steps are searched when not set.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return. All matches are returned when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression the name of each result must match.",
+				Optional:    true,
 			},
 			"ids": schema.ListAttribute{
 				Computed:    true,
 				ElementType: types.Int32Type,
 			},
+			"common_steps": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A summary of each of the common steps found, in the same order as ids.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.Int32Attribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *WebJourneyCommonStepsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data GenericMultipleDataSource
+	var data CommonStepsDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ids, err := d.client.SearchWebJoureyCommonSteps(data.Search.ValueString())
+	nameRegex := compileNameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commonSteps, err := d.client.ListWebJourneyCommonSteps(data.Search.ValueString(), int(data.Limit.ValueInt64()), func(commonStep WebJourneyCommonStep) bool {
+		return matchesRegex(nameRegex, commonStep.Name)
+	}, ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error searching web journey common steps",
@@ -55,7 +88,13 @@ func (d *WebJourneyCommonStepsDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
-	data.Ids = ids
+	data.Ids = []types.Int32{}
+	data.CommonSteps = []NamedItemSummaryModel{}
+
+	for _, commonStep := range commonSteps {
+		data.Ids = append(data.Ids, types.Int32Value(int32(commonStep.Id)))
+		data.CommonSteps = append(data.CommonSteps, mapToWebJourneyCommonStepSummaryModel(commonStep))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
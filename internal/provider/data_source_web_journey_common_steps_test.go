@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccWebJourneyCommonStepsDataSource(t *testing.T) {
+	server := testAccFake(t)
+
+	logIn := server.Add(fakeepm.WebJourneyCommonSteps, map[string]any{"name": "Log In", "description": "Logs in.", "waitTime": 5000, "warningPageLoadTime": 2500, "alertPageLoadTime": 5000})
+	server.Add(fakeepm.WebJourneyCommonSteps, map[string]any{"name": "Log Out", "description": "Logs out.", "waitTime": 5000, "warningPageLoadTime": 2500, "alertPageLoadTime": 5000})
+	server.Add(fakeepm.WebJourneyCommonSteps, map[string]any{"name": "Accept Terms", "description": "Accepts the terms.", "waitTime": 5000, "warningPageLoadTime": 2500, "alertPageLoadTime": 5000})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "endpointmonitor_web_journey_common_steps" "search" {
+  search = "log"
+}
+
+data "endpointmonitor_web_journey_common_steps" "regex" {
+  name_regex = "^Log In$"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.endpointmonitor_web_journey_common_steps.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_web_journey_common_steps.regex", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_web_journey_common_steps.regex", "common_steps.0.id", fmt.Sprint(logIn)),
+					resource.TestCheckResourceAttr("data.endpointmonitor_web_journey_common_steps.regex", "common_steps.0.name", "Log In"),
+					resource.TestCheckResourceAttr("data.endpointmonitor_web_journey_common_steps.regex", "common_steps.0.description", "Logs in."),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultResultRetention is the number of days results are kept for when neither the
+// check nor the provider's defaults block sets it.
+const defaultResultRetention = 366
+
+// CheckDefaults are values from the provider's defaults block used for any check that
+// doesn't set them itself. Null values have no default.
+type CheckDefaults struct {
+	CheckGroupId    types.Int32 `tfsdk:"check_group_id"`
+	HostGroupId     types.Int32 `tfsdk:"check_host_group_id"`
+	ProxyHostId     types.Int32 `tfsdk:"proxy_host_id"`
+	TriggerCount    types.Int32 `tfsdk:"trigger_count"`
+	ResultRetention types.Int32 `tfsdk:"result_retention"`
+}
+
+// applyCheckDefaults sets the attributes common to all checks that aren't set in the
+// configuration to the provider's defaults in the plan, so the values used are shown
+// when planning. Attributes that must have a value are reported if there's no default.
+func (c *EndPointMonitorClient) applyCheckDefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defaults := c.CheckDefaults
+
+	if defaults.ResultRetention.IsNull() {
+		defaults.ResultRetention = types.Int32Value(defaultResultRetention)
+	}
+
+	// A check runs on either a host or a host group, so the default host group is
+	// only used when neither is set.
+	var checkHostId types.Int32
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("check_host_id"), &checkHostId)...)
+	if !checkHostId.IsNull() {
+		defaults.HostGroupId = types.Int32Null()
+	}
+
+	attributes := []struct {
+		name     string
+		value    types.Int32
+		required bool
+	}{
+		{"check_group_id", defaults.CheckGroupId, true},
+		{"check_host_group_id", defaults.HostGroupId, false},
+		{"proxy_host_id", defaults.ProxyHostId, false},
+		{"trigger_count", defaults.TriggerCount, true},
+		{"result_retention", defaults.ResultRetention, true},
+	}
+
+	for _, attribute := range attributes {
+		var configured types.Int32
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute.name), &configured)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !configured.IsNull() {
+			continue
+		}
+
+		if attribute.required && attribute.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Missing Check Attribute",
+				"The "+attribute.name+" attribute must be set, either on the check or in the defaults block of the provider.",
+			)
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute.name), attribute.value)...)
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+// testAccCheckStoredGroup checks the check of a resource is in the given check group
+// in the fake.
+func testAccCheckStoredGroup(server *fakeepm.Server, resourceName string, checkGroupId int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", resourceName)
+		}
+
+		var id int64
+		if _, err := fmt.Sscan(rs.Primary.ID, &id); err != nil {
+			return fmt.Errorf("%s has an invalid id %q", resourceName, rs.Primary.ID)
+		}
+
+		item, _ := server.Get(fakeepm.Checks, id)
+		checkGroup, _ := item["checkGroup"].(map[string]any)
+
+		if got := fmt.Sprint(checkGroup["id"]); got != fmt.Sprint(checkGroupId) {
+			return fmt.Errorf("%s is in check group %s in the fake, want %d", resourceName, got, checkGroupId)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckDefaults(t *testing.T) {
+	server := testAccFake(t)
+
+	dashboardGroup := map[string]any{"id": server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Operations", "description": "Operations dashboard."})}
+	operations := server.Add(fakeepm.CheckGroups, map[string]any{"name": "Operations", "description": "Operations checks.", "dashboardGroup": dashboardGroup})
+	website := server.Add(fakeepm.CheckGroups, map[string]any{"name": "Website", "description": "Website checks.", "dashboardGroup": dashboardGroup})
+	agent := server.Add(fakeepm.CheckHosts, map[string]any{"hostname": "test-agent-01.mycompany.com", "description": "Test agent.", "type": "AGENT", "enabled": true})
+
+	config := func(defaults string, checkGroup string) string {
+		return fmt.Sprintf(`
+provider "endpointmonitor" {
+  url = %q
+  key = %q
+
+  defaults = {
+%s
+  }
+}
+
+resource "endpointmonitor_ping_check" "test" {
+  name                  = "Test Ping Check"
+  description           = "Test ping check."
+  check_frequency       = 30
+  hostname              = "www.mycompany.com"
+  warning_response_time = 2000
+  timeout_time          = 5000
+  trigger_count         = 3
+  check_host_id         = %d
+%s
+}
+`, server.APIURL(), testAccKey, defaults, agent, checkGroup)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				// Without a check group given or a default, there's nothing to use.
+				Config:      config("    trigger_count = 2", ""),
+				ExpectError: regexp.MustCompile(`The check_group_id attribute must be set`),
+			},
+			{
+				// Attributes that aren't set take the defaults, while those that are
+				// keep the values given.
+				Config: config(fmt.Sprintf(`
+    check_group_id   = %d
+    trigger_count    = 2
+    result_retention = 30
+`, operations), ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_ping_check.test", "check_group_id", fmt.Sprint(operations)),
+					resource.TestCheckResourceAttr("endpointmonitor_ping_check.test", "trigger_count", "3"),
+					resource.TestCheckResourceAttr("endpointmonitor_ping_check.test", "result_retention", "30"),
+					resource.TestCheckNoResourceAttr("endpointmonitor_ping_check.test", "check_host_group_id"),
+					testAccCheckStoredGroup(server, "endpointmonitor_ping_check.test", operations),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_ping_check.test", map[string]any{
+						"triggerCount":        3,
+						"resultRetentionDays": 30,
+					}),
+				),
+			},
+			{
+				// Changing a default changes the checks that use it.
+				Config: config(fmt.Sprintf(`
+    check_group_id   = %d
+    trigger_count    = 5
+    result_retention = 30
+`, website), ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("endpointmonitor_ping_check.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("endpointmonitor_ping_check.test", tfjsonpath.New("check_group_id"), knownvalue.Int32Exact(int32(website))),
+						plancheck.ExpectKnownValue("endpointmonitor_ping_check.test", tfjsonpath.New("trigger_count"), knownvalue.Int32Exact(3)),
+					},
+				},
+				Check: testAccCheckStoredGroup(server, "endpointmonitor_ping_check.test", website),
+			},
+			{
+				// A check group given on the check is used over the default.
+				Config: config(fmt.Sprintf(`
+    check_group_id = %d
+`, website), fmt.Sprintf("  check_group_id        = %d", operations)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("endpointmonitor_ping_check.test", tfjsonpath.New("check_group_id"), knownvalue.Int32Exact(int32(operations))),
+						plancheck.ExpectKnownValue("endpointmonitor_ping_check.test", tfjsonpath.New("result_retention"), knownvalue.Int32Exact(defaultResultRetention)),
+					},
+				},
+				Check: testAccCheckStoredGroup(server, "endpointmonitor_ping_check.test", operations),
+			},
+		},
+	})
+}
@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// endpoint describes how one type of EPM item is read and written through the API,
+// with A being the type sent to and returned by the API and M the Terraform model it
+// is mapped to and from.
+type endpoint[A any, M any] struct {
+	// path is the base path of the item, which it is read from at path/{id}, removed
+	// from at path/remove/{id} and listed from at path/list.
+	path string
+	// addPath and updatePath are where new and changed items are sent. They default
+	// to path/add and path/update.
+	addPath    string
+	updatePath string
+
+	toAPI   func(M) A
+	toModel func(A) M
+	id      func(A) int64
+	// labels gives the name and description of an item that the provider's naming
+	// settings apply to. Either may be nil, and items without labels are left as
+	// they are.
+	labels func(*A) (name *string, description *string)
+}
+
+var (
+	checkGroupEndpoint = endpoint[CheckGroup, CheckGroupModel]{
+		path:    "/checkGroups",
+		toAPI:   mapToCheckGroup,
+		toModel: mapToCheckGroupModel,
+		id:      func(g CheckGroup) int64 { return int64(g.Id) },
+		labels:  func(g *CheckGroup) (*string, *string) { return &g.Name, &g.Description },
+	}
+
+	checkHostEndpoint = endpoint[CheckHost, CheckHostModel]{
+		path:    "/hosts",
+		toAPI:   mapToCheckHost,
+		toModel: mapToCheckHostModel,
+		id:      func(h CheckHost) int64 { return int64(h.Id) },
+		labels:  func(h *CheckHost) (*string, *string) { return nil, &h.Description },
+	}
+
+	dashboardGroupEndpoint = endpoint[DashboardGroup, DashboardGroupModel]{
+		path:    "/dashboardGroups",
+		toAPI:   mapToDashboardGroup,
+		toModel: mapToDashboardGroupModel,
+		id:      func(g DashboardGroup) int64 { return int64(g.Id) },
+		labels:  func(g *DashboardGroup) (*string, *string) { return &g.Name, &g.Description },
+	}
+
+	hostGroupEndpoint = endpoint[HostGroup, HostGroupModel]{
+		path:    "/hostGroups",
+		toAPI:   mapToHostGroup,
+		toModel: mapToHostGroupModel,
+		id:      func(g HostGroup) int64 { return int64(g.Id) },
+		labels:  func(g *HostGroup) (*string, *string) { return &g.Name, &g.Description },
+	}
+
+	maintenancePeriodEndpoint = endpoint[MaintenancePeriod, MaintenancePeriodModel]{
+		path:    "/maintenancePeriods",
+		toAPI:   mapToMaintenancePeriod,
+		toModel: mapToMaintenancePeriodModel,
+		id:      func(p MaintenancePeriod) int64 { return int64(p.Id) },
+	}
+
+	proxyHostEndpoint = endpoint[ProxyHost, ProxyHostModel]{
+		path:    "/proxies",
+		toAPI:   mapToProxyHost,
+		toModel: mapToProxyHostModel,
+		id:      func(h ProxyHost) int64 { return int64(h.Id) },
+		labels:  func(h *ProxyHost) (*string, *string) { return &h.Name, &h.Description },
+	}
+
+	androidJourneyCommonStepEndpoint = endpoint[AndroidJourneyCommonStep, AndroidJourneyCommonStepModel]{
+		path:    "/checks/commonSteps/android",
+		toAPI:   mapToAndroidJourneyCommonStep,
+		toModel: mapToAndroidJourneyCommonStepModel,
+		id:      func(s AndroidJourneyCommonStep) int64 { return s.Id },
+	}
+
+	webJourneyCommonStepEndpoint = endpoint[WebJourneyCommonStep, WebJourneyCommonStepModel]{
+		path:    "/checks/commonSteps/web",
+		toAPI:   mapToWebJourneyCommonStep,
+		toModel: mapToWebJourneyCommonStepModel,
+		id:      func(s WebJourneyCommonStep) int64 { return int64(s.Id) },
+	}
+
+	// checkEndpoint covers every type of check when listing and removing them. Each
+	// type of check has its own endpoint below for adding, updating and reading.
+	checkEndpoint = endpoint[Check, Check]{
+		path:   "/checks",
+		id:     func(c Check) int64 { return c.Id },
+		labels: func(c *Check) (*string, *string) { return &c.Name, &c.Description },
+	}
+
+	certificateCheckEndpoint = endpoint[CertificateCheck, CertificateCheckModel]{
+		path:       "/checks",
+		addPath:    "/checks/add/certificate",
+		updatePath: "/checks/update/certificate",
+		toAPI:      mapToCertificateCheck,
+		toModel:    mapToCertificateCheckModel,
+		labels:     func(c *CertificateCheck) (*string, *string) { return &c.Name, &c.Description },
+	}
+
+	dnsCheckEndpoint = endpoint[DnsCheck, DnsCheckModel]{
+		path:       "/checks",
+		addPath:    "/checks/add/dns",
+		updatePath: "/checks/update/dns",
+		toAPI:      mapToDnsCheck,
+		toModel:    mapToDnsCheckModel,
+		labels:     func(c *DnsCheck) (*string, *string) { return &c.Name, &c.Description },
+	}
+
+	pingCheckEndpoint = endpoint[PingCheck, PingCheckModel]{
+		path:       "/checks",
+		addPath:    "/checks/add/ping",
+		updatePath: "/checks/update/ping",
+		toAPI:      mapToPingCheck,
+		toModel:    mapToPingCheckModel,
+		labels:     func(c *PingCheck) (*string, *string) { return &c.Name, &c.Description },
+	}
+
+	socketCheckEndpoint = endpoint[SocketCheck, SocketCheckModel]{
+		path:       "/checks",
+		addPath:    "/checks/add/socket",
+		updatePath: "/checks/update/socket",
+		toAPI:      mapToSocketCheck,
+		toModel:    mapToSocketCheckModel,
+		labels:     func(c *SocketCheck) (*string, *string) { return &c.Name, &c.Description },
+	}
+
+	urlCheckEndpoint = endpoint[UrlCheck, UrlCheckModel]{
+		path:       "/checks",
+		addPath:    "/checks/add/url",
+		updatePath: "/checks/update/url",
+		toAPI:      mapToUrlCheck,
+		toModel:    mapToUrlCheckModel,
+		labels:     func(c *UrlCheck) (*string, *string) { return &c.Name, &c.Description },
+	}
+
+	androidJourneyCheckEndpoint = endpoint[AndroidJourneyCheck, AndroidJourneyCheckModel]{
+		path:       "/checks",
+		addPath:    "/checks/add/androidJourney",
+		updatePath: "/checks/update/androidJourney",
+		toAPI:      mapToAndroidJourneyCheck,
+		toModel:    mapToAndroidJourneyCheckModel,
+		labels:     func(c *AndroidJourneyCheck) (*string, *string) { return &c.Name, &c.Description },
+	}
+
+	webJourneyCheckEndpoint = endpoint[WebJourneyCheck, WebJourneyCheckModel]{
+		path:       "/checks",
+		addPath:    "/checks/add/webJourney",
+		updatePath: "/checks/update/webJourney",
+		toAPI:      mapToWebJourneyCheck,
+		toModel:    mapToWebJourneyCheckModel,
+		labels:     func(c *WebJourneyCheck) (*string, *string) { return &c.Name, &c.Description },
+	}
+)
+
+func (e endpoint[A, M]) get(c *EndPointMonitorClient, id int64, ctx context.Context) (*M, error) {
+	var item A
+
+	err := c.requestJSON(http.MethodGet, fmt.Sprintf("%s/%d", e.path, id), nil, &item, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e.strip(c, &item)
+	model := e.toModel(item)
+
+	return &model, nil
+}
+
+// decode maps an item that has already been read from the API, such as a check read
+// before its type was known.
+func (e endpoint[A, M]) decode(c *EndPointMonitorClient, data json.RawMessage) (*M, error) {
+	var item A
+
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, err
+	}
+
+	e.strip(c, &item)
+	model := e.toModel(item)
+
+	return &model, nil
+}
+
+// create adds a new item. The EPM API uses PUT to add items and POST to update them.
+func (e endpoint[A, M]) create(c *EndPointMonitorClient, model M, ctx context.Context) (*M, error) {
+	path := e.addPath
+	if path == "" {
+		path = e.path + "/add"
+	}
+
+	return e.send(c, http.MethodPut, path, model, ctx)
+}
+
+func (e endpoint[A, M]) update(c *EndPointMonitorClient, model M, ctx context.Context) (*M, error) {
+	path := e.updatePath
+	if path == "" {
+		path = e.path + "/update"
+	}
+
+	return e.send(c, http.MethodPost, path, model, ctx)
+}
+
+func (e endpoint[A, M]) send(c *EndPointMonitorClient, method string, path string, model M, ctx context.Context) (*M, error) {
+	var item A
+
+	sent := e.toAPI(model)
+	if e.labels != nil {
+		c.Naming.stamp(e.labels(&sent))
+	}
+
+	err := c.requestJSON(method, path, sent, &item, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e.strip(c, &item)
+	newModel := e.toModel(item)
+
+	return &newModel, nil
+}
+
+// strip removes the provider's naming settings from an item read from the API.
+func (e endpoint[A, M]) strip(c *EndPointMonitorClient, item *A) {
+	if e.labels != nil {
+		c.Naming.strip(e.labels(item))
+	}
+}
+
+func (e endpoint[A, M]) delete(c *EndPointMonitorClient, id int64, ctx context.Context) error {
+	result := struct {
+		Success bool `json:"success"`
+	}{}
+
+	err := c.requestJSON(http.MethodDelete, fmt.Sprintf("%s/remove/%d", e.path, id), nil, &result, ctx)
+	if err != nil {
+		return err
+	}
+
+	if !result.Success {
+		return fmt.Errorf("EPM did not confirm removal of %s/%d", e.path, id)
+	}
+
+	return nil
+}
+
+// maxSearchPages stops a search from looping forever against an API that keeps
+// returning results, however many pages are requested. The descriptions of the plural
+// data sources give it, as their filters only see the items on these pages.
+const maxSearchPages = 1000
+
+// search returns the items whose names match the search string, walking every page of
+// results. Items are only kept if keep returns true for them, when it isn't nil, once
+// the provider's naming settings are removed from them. A limit greater than zero
+// stops the search once that many items are kept.
+func (e endpoint[A, M]) search(c *EndPointMonitorClient, search string, limit int, keep func(A) bool, ctx context.Context) ([]A, error) {
+	items := []A{}
+	seen := map[int64]bool{}
+
+	for page := 0; page < maxSearchPages; page++ {
+		pageItems := []A{}
+
+		err := c.requestCachedJSON(fmt.Sprintf("%s/list?page=%d&search=%s", e.path, page, url.QueryEscape(search)), &pageItems, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		found := 0
+
+		for _, item := range pageItems {
+			id := e.id(item)
+			if seen[id] {
+				continue
+			}
+
+			seen[id] = true
+			found++
+
+			e.strip(c, &item)
+			if keep != nil && !keep(item) {
+				continue
+			}
+
+			items = append(items, item)
+
+			if limit > 0 && len(items) >= limit {
+				return items, nil
+			}
+		}
+
+		// An empty page marks the end of the results, as does a page of items that
+		// have all been seen before from a server that ignores the page requested.
+		if found == 0 {
+			break
+		}
+	}
+
+	return items, nil
+}
+
+// searchIds returns the ids of the items whose names match the search string.
+func (e endpoint[A, M]) searchIds(c *EndPointMonitorClient, search string, limit int, ctx context.Context) ([]int64, error) {
+	items, err := e.search(c, search, limit, nil, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(items))
+
+	for _, item := range items {
+		ids = append(ids, e.id(item))
+	}
+
+	return ids, nil
+}
+
+// requestJSON sends a request to the EPM API, with in sent as the JSON body when not
+// nil and the JSON response decoded into out.
+func (c *EndPointMonitorClient) requestJSON(method string, path string, in any, out any, ctx context.Context) error {
+	return c.exchangeJSON(method, path, in, out, false, ctx)
+}
+
+// requestCachedJSON sends a GET request to the EPM API, reusing any earlier response
+// to the same request since the last change was made through the client.
+func (c *EndPointMonitorClient) requestCachedJSON(path string, out any, ctx context.Context) error {
+	return c.exchangeJSON(http.MethodGet, path, nil, out, true, ctx)
+}
+
+func (c *EndPointMonitorClient) exchangeJSON(method string, path string, in any, out any, cache bool, ctx context.Context) error {
+	var body io.Reader
+
+	if in != nil {
+		rb, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(rb)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.HostURL+path, body)
+	if err != nil {
+		return err
+	}
+
+	var rb []byte
+
+	switch {
+	case c.cache == nil:
+		rb, err = c.doRequest(req)
+	case method == http.MethodGet:
+		rb, err = c.cache.get(path, cache, func() ([]byte, error) {
+			return c.doRequest(req)
+		}, ctx)
+	default:
+		c.cache.flush()
+		defer c.cache.flush()
+
+		rb, err = c.doRequest(req)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(rb, out); err != nil {
+		return fmt.Errorf("unable to read response from %s %s: %w", method, path, err)
+	}
+
+	return nil
+}
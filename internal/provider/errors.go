@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ErrNotFound is matched, using errors.Is, by errors returned for items that don't
+// exist in EPM. A resource whose item is not found when it is read has been removed
+// outside of Terraform, so is dropped from state to have it recreated.
+var ErrNotFound = errors.New("not found")
+
+// ErrReadOnly is returned for any request that would change EPM when the client is
+// read only.
+var ErrReadOnly = errors.New("the provider is read only and will not make changes to EndPoint Monitor")
+
+// APIError is returned by the client when the EPM API responds with an unsuccessful
+// status code.
+type APIError struct {
+	StatusCode  int
+	Method      string
+	Path        string
+	Code        string
+	Message     string
+	FieldErrors []APIFieldError
+	Body        string
+}
+
+// APIFieldError is a validation failure the EPM API reported against a single field
+// of the request body, using the API's own (JSON) field name.
+type APIFieldError struct {
+	Field   string
+	Message string
+}
+
+// apiErrorResponse covers the shapes of error body returned by the EPM API.
+type apiErrorResponse struct {
+	Code    string `json:"code"`
+	Error   string `json:"error"`
+	Message string `json:"message"`
+	Errors  []struct {
+		Field          string `json:"field"`
+		Message        string `json:"message"`
+		DefaultMessage string `json:"defaultMessage"`
+	} `json:"errors"`
+}
+
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	apiError := APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Body:       string(body),
+	}
+
+	response := apiErrorResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return &apiError
+	}
+
+	apiError.Code = response.Code
+	if apiError.Code == "" {
+		apiError.Code = response.Error
+	}
+
+	apiError.Message = response.Message
+
+	for _, fieldError := range response.Errors {
+		message := fieldError.Message
+		if message == "" {
+			message = fieldError.DefaultMessage
+		}
+
+		apiError.FieldErrors = append(apiError.FieldErrors, APIFieldError{
+			Field:   fieldError.Field,
+			Message: message,
+		})
+	}
+
+	return &apiError
+}
+
+// Is allows errors.Is(err, ErrNotFound) to match an API error for a 404 response.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+func (e *APIError) Error() string {
+	message := fmt.Sprintf("%s %s returned status %d", e.Method, e.Path, e.StatusCode)
+
+	if e.Code != "" {
+		message += " (" + e.Code + ")"
+	}
+
+	if e.Message == "" && len(e.FieldErrors) == 0 {
+		return message + ", body: " + e.Body
+	}
+
+	if e.Message != "" {
+		message += ": " + e.Message
+	}
+
+	for _, fieldError := range e.FieldErrors {
+		message += "\n  " + fieldError.Field + ": " + fieldError.Message
+	}
+
+	return message
+}
+
+// apiFieldNames maps EPM API field names onto the attribute names used in the
+// Terraform schemas where they aren't simply the snake case of each other. An empty
+// name marks a field that has no single matching attribute.
+var apiFieldNames = map[string]string{
+	"checkGroup":                       "check_group_id",
+	"checkHost":                        "check_host_id",
+	"hostGroup":                        "check_host_group_id",
+	"proxyHost":                        "proxy_host_id",
+	"dashboardGroup":                   "dashboard_group_id",
+	"resultRetentionDays":              "result_retention",
+	"maxWebJourneyChecks":              "max_checks",
+	"checkDatesOnly":                   "check_date_only",
+	"requestHeaders":                   "request_header",
+	"responseCheckStrings":             "response_body_check",
+	"monitorDomains":                   "monitor_domain",
+	"hosts":                            "check_host_ids",
+	"checks":                           "check_ids",
+	"checkGroups":                      "check_group_ids",
+	"dashboardGroups":                  "dashboard_group_ids",
+	"steps":                            "step",
+	"commonId":                         "common_step_id",
+	"warningPageLoadTime":              "page_load_time_warning",
+	"alertPageLoadTime":                "page_load_time_alert",
+	"pageChecks":                       "page_check",
+	"alertSuppressions":                "",
+	"actions":                          "action",
+	"pageCheckForText":                 "check_for_text",
+	"pageCheckForElement":              "check_element_on_page",
+	"pageCheckCurrentURL":              "check_current_url",
+	"pageCheckURLResponse":             "check_url_response",
+	"pageCheckConsoleLog":              "check_console_log",
+	"webJourneyClickAction":            "click",
+	"webJourneyTextInputAction":        "text_input",
+	"webJourneyPasswordInputAction":    "password_input",
+	"webJourneyScrollToElement":        "scroll_to_element",
+	"webJourneySelectOption":           "select_option",
+	"newInputPassword":                 "input_password",
+	"stepChecks":                       "step_check",
+	"stepInteractions":                 "step_interaction",
+	"androidClickAction":               "click",
+	"androidInputTextAction":           "text_input",
+	"androidInputPasswordAction":       "password_input",
+	"androidRotateDisplayAction":       "rotate_display",
+	"androidSelectSpinnerOptionAction": "select_spinner_option",
+	"androidSwipeAction":               "swipe",
+}
+
+var apiFieldSegment = regexp.MustCompile(`^([A-Za-z0-9_]+)(?:\[(\d+)\])?$`)
+
+// apiFieldPath converts an API field name, such as "steps[0].pageChecks[1].type", into
+// the matching Terraform attribute path. Conversion stops at the first segment that has
+// no matching attribute, returning the deepest path found and whether all of the field
+// could be converted. Renames override apiFieldNames for resources that differ.
+func apiFieldPath(field string, renames map[string]string) (path.Path, bool) {
+	result := path.Empty()
+
+	for _, segment := range strings.Split(field, ".") {
+		match := apiFieldSegment.FindStringSubmatch(segment)
+		if match == nil {
+			return result, false
+		}
+
+		name, ok := renames[match[1]]
+		if !ok {
+			name, ok = apiFieldNames[match[1]]
+		}
+		if !ok {
+			name = toSnakeCase(match[1])
+		}
+
+		if name == "" {
+			return result, false
+		}
+
+		result = result.AtName(name)
+
+		if match[2] != "" {
+			index, _ := strconv.Atoi(match[2])
+			result = result.AtListIndex(index)
+		}
+	}
+
+	return result, true
+}
+
+func toSnakeCase(name string) string {
+	var builder strings.Builder
+
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				builder.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+// addAPIErrorDiagnostics reports an error from the client. Field level validation
+// errors from the EPM API are attached to the matching attribute so Terraform can
+// point at the part of the configuration that is wrong, with anything else reported
+// as a general error.
+func addAPIErrorDiagnostics(diags *diag.Diagnostics, summary string, detail string, err error, renames ...map[string]string) {
+	var apiError *APIError
+
+	if !errors.As(err, &apiError) || len(apiError.FieldErrors) == 0 {
+		diags.AddError(summary, detail+", unexpected error: "+err.Error())
+		return
+	}
+
+	var fieldRenames map[string]string
+	if len(renames) > 0 {
+		fieldRenames = renames[0]
+	}
+
+	for _, fieldError := range apiError.FieldErrors {
+		attributePath, complete := apiFieldPath(fieldError.Field, fieldRenames)
+		message := detail + ": " + fieldError.Message
+
+		if !complete {
+			message += " (API field: " + fieldError.Field + ")"
+		}
+
+		if len(attributePath.Steps()) == 0 {
+			diags.AddError(summary, message)
+			continue
+		}
+
+		diags.AddAttributeError(attributePath, summary, message)
+	}
+
+	if apiError.Message != "" {
+		diags.AddError(summary, detail+": "+apiError.Message)
+	}
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAPIFieldPath(t *testing.T) {
+	tests := []struct {
+		field        string
+		renames      map[string]string
+		want         path.Path
+		wantComplete bool
+	}{
+		{field: "name", want: path.Root("name"), wantComplete: true},
+		{field: "checkFrequency", want: path.Root("check_frequency"), wantComplete: true},
+		{field: "checkGroup", want: path.Root("check_group_id"), wantComplete: true},
+		{field: "resultRetentionDays", want: path.Root("result_retention"), wantComplete: true},
+		{field: "requestHeaders[1].value", want: path.Root("request_header").AtListIndex(1).AtName("value"), wantComplete: true},
+		{
+			field:        "steps[0].pageChecks[1].pageCheckForElement.xpath",
+			want:         path.Root("step").AtListIndex(0).AtName("page_check").AtListIndex(1).AtName("check_element_on_page").AtName("xpath"),
+			wantComplete: true,
+		},
+		{
+			field:        "steps[2].actions[0].webJourneyPasswordInputAction.newInputPassword",
+			want:         path.Root("step").AtListIndex(2).AtName("action").AtListIndex(0).AtName("password_input").AtName("input_password"),
+			wantComplete: true,
+		},
+		{field: "steps[0].alertSuppressions[0].url", want: path.Root("step").AtListIndex(0)},
+		{field: "alertSuppressions", want: path.Empty()},
+		{field: "steps[0].name", renames: androidJourneyFieldNames, want: path.Empty()},
+		{field: "steps", renames: map[string]string{"steps": "custom_step"}, want: path.Root("custom_step"), wantComplete: true},
+		{field: "name[x]", want: path.Empty()},
+		{field: "", want: path.Empty()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.field, func(t *testing.T) {
+			got, complete := apiFieldPath(test.field, test.renames)
+
+			if !got.Equal(test.want) || complete != test.wantComplete {
+				t.Errorf("apiFieldPath(%q) = %s, %t, want %s, %t", test.field, got, complete, test.want, test.wantComplete)
+			}
+		})
+	}
+}
+
+// testDiagnosticPaths returns the attribute path of each diagnostic, or an empty
+// string for one that isn't attached to an attribute.
+func testDiagnosticPaths(diags diag.Diagnostics) []string {
+	paths := []string{}
+
+	for _, d := range diags {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			paths = append(paths, withPath.Path().String())
+		} else {
+			paths = append(paths, "")
+		}
+	}
+
+	return paths
+}
+
+func TestAddAPIErrorDiagnostics(t *testing.T) {
+	err := &APIError{
+		StatusCode: http.StatusBadRequest,
+		Message:    "Validation failed",
+		FieldErrors: []APIFieldError{
+			{Field: "checkFrequency", Message: "must be at least 10"},
+			{Field: "steps[0].alertSuppressions[0].url", Message: "must not be blank"},
+			{Field: "unmatched!", Message: "is invalid"},
+		},
+	}
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostics(&diags, "Error creating check", "Could not create check", err)
+
+	want := []string{"check_frequency", "step[0]", "", ""}
+	if got := testDiagnosticPaths(diags); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("got diagnostics at %q, want %q", got, want)
+	}
+
+	if detail := diags[1].Detail(); !strings.Contains(detail, "(API field: steps[0].alertSuppressions[0].url)") {
+		t.Errorf("partly matched field doesn't name the API field: %s", detail)
+	}
+
+	if detail := diags[3].Detail(); detail != "Could not create check: Validation failed" {
+		t.Errorf("got general detail %q", detail)
+	}
+
+	// Errors without field errors are reported as a single general error.
+	diags = nil
+	addAPIErrorDiagnostics(&diags, "Error creating check", "Could not create check", errors.New("connection refused"))
+
+	if got := testDiagnosticPaths(diags); len(got) != 1 || got[0] != "" {
+		t.Errorf("got diagnostics at %q, want one general error", got)
+	}
+}
+
+func TestAPIFieldErrorsFromFake(t *testing.T) {
+	server := testAccFake(t)
+
+	client, err := NewEPMClient(EndPointMonitorClientConfig{HostURL: server.APIURL(), ApiKey: testAccKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.Reject(http.StatusBadRequest, map[string]any{
+		"error":   "Bad Request",
+		"message": "Validation failed",
+		"errors": []any{
+			map[string]any{"field": "name", "defaultMessage": "must not be blank"},
+		},
+	})
+
+	_, err = client.CreateDashboardGroup(DashboardGroupModel{Name: types.StringValue(""), Description: types.StringValue("")}, context.Background())
+	if err == nil {
+		t.Fatal("rejected dashboard group was created")
+	}
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostics(&diags, "Error creating dashboard group", "Could not create dashboard group", err)
+
+	if got := testDiagnosticPaths(diags); len(got) != 2 || got[0] != "name" {
+		t.Fatalf("got diagnostics at %q, want name then a general error", got)
+	}
+
+	if detail := diags[0].Detail(); detail != "Could not create dashboard group: must not be blank" {
+		t.Errorf("got detail %q", detail)
+	}
+}
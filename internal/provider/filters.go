@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The EPM API can only search items by name, so the filters of the plural data
+// sources are applied by the provider to the items each search returns. Each filter
+// matches everything when it isn't set.
+
+// compileNameRegex compiles the name_regex filter of a plural data source, adding an
+// error for it if it isn't a valid regular expression. It returns nil when not set.
+func compileNameRegex(nameRegex types.String, diags *diag.Diagnostics) *regexp.Regexp {
+	if nameRegex.IsNull() {
+		return nil
+	}
+
+	re, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Name Regex",
+			"The name_regex filter is not a valid regular expression: "+err.Error(),
+		)
+		return nil
+	}
+
+	return re
+}
+
+func matchesRegex(re *regexp.Regexp, value string) bool {
+	return re == nil || re.MatchString(value)
+}
+
+func matchesString(filter types.String, value string) bool {
+	return filter.IsNull() || filter.ValueString() == value
+}
+
+func matchesBool(filter types.Bool, value bool) bool {
+	return filter.IsNull() || filter.ValueBool() == value
+}
+
+// matchesId compares an id filter with the id of a related item, which is null if there
+// is no related item, in which case only an unset filter matches.
+func matchesId(filter types.Int32, id types.Int32) bool {
+	return filter.IsNull() || filter.Equal(id)
+}
+
+// containsId reports whether a list of the ids of related items includes the filter.
+func containsId(filter types.Int32, ids []types.Int32) bool {
+	if filter.IsNull() {
+		return true
+	}
+
+	for _, id := range ids {
+		if filter.Equal(id) {
+			return true
+		}
+	}
+
+	return false
+}
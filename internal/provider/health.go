@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ServerInfo is what the EPM API reports about itself.
+type ServerInfo struct {
+	Version string `json:"version"`
+	// Capabilities lists the features the server supports, if it reports them. See
+	// capabilities.go for how features are named.
+	Capabilities []string `json:"capabilities"`
+}
+
+// CheckHealth confirms the EPM API can be reached and accepts the client's credentials,
+// recording what the server reports about itself in ServerInfo. Servers that don't
+// report anything about themselves leave ServerInfo unset.
+func (c *EndPointMonitorClient) CheckHealth(ctx context.Context) error {
+	info := ServerInfo{}
+
+	err := c.requestJSON(http.MethodGet, "/info", nil, &info, ctx)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	if err == nil {
+		c.ServerInfo = &info
+	}
+
+	// The server info may not need authenticating, so confirm the credentials work
+	// with a small authenticated request.
+	dashboardGroups := []json.RawMessage{}
+
+	return c.requestJSON(http.MethodGet, "/dashboardGroups/list?page=0&search=", nil, &dashboardGroups, ctx)
+}
+
+// addHealthCheckDiagnostics reports a failed health check against the setting most
+// likely to be wrong.
+func addHealthCheckDiagnostics(diags *diag.Diagnostics, url string, err error) {
+	var dnsError *net.DNSError
+	var opError *net.OpError
+	var apiError *APIError
+	var certificateError *tls.CertificateVerificationError
+	var unknownAuthorityError x509.UnknownAuthorityError
+	var hostnameError x509.HostnameError
+	var recordHeaderError tls.RecordHeaderError
+
+	switch {
+	case errors.As(err, &dnsError):
+		diags.AddAttributeError(
+			path.Root("url"),
+			"Unable to Resolve EndPointMonitor Host",
+			"The host in the EndPointMonitor URL "+url+" could not be found. Check the url setting is correct.\n\n"+err.Error(),
+		)
+	case errors.As(err, &certificateError), errors.As(err, &unknownAuthorityError), errors.As(err, &hostnameError):
+		diags.AddAttributeError(
+			path.Root("url"),
+			"Unable to Verify EndPointMonitor TLS Certificate",
+			"The TLS certificate presented by "+url+" could not be verified. If your installation uses a certificate "+
+				"issued by an internal CA, set ca_cert_file or ca_cert_pem to trust it.\n\n"+err.Error(),
+		)
+	case errors.As(err, &recordHeaderError), strings.Contains(err.Error(), "server gave HTTP response to HTTPS client"):
+		diags.AddAttributeError(
+			path.Root("url"),
+			"Unable to Connect to EndPointMonitor with TLS",
+			"The server at "+url+" did not respond to a TLS handshake. Check whether the url should start with http:// rather than https://.\n\n"+err.Error(),
+		)
+	case errors.As(err, &apiError) && apiError.StatusCode == http.StatusUnauthorized:
+		diags.AddAttributeError(
+			path.Root("key"),
+			"EndPointMonitor API Key Rejected",
+			"The EndPointMonitor API at "+url+" did not accept the credentials given. Check the API key is correct and hasn't been revoked.\n\n"+err.Error(),
+		)
+	case errors.As(err, &apiError) && apiError.StatusCode == http.StatusForbidden:
+		diags.AddAttributeError(
+			path.Root("key"),
+			"EndPointMonitor API Key Not Permitted",
+			"The EndPointMonitor API at "+url+" accepted the credentials given, but they don't have access to the API. "+
+				"Check the API key has been given access.\n\n"+err.Error(),
+		)
+	case errors.As(err, &apiError) && apiError.StatusCode == http.StatusNotFound:
+		diags.AddAttributeError(
+			path.Root("url"),
+			"EndPointMonitor API Not Found",
+			"The EndPointMonitor API could not be found at "+url+". The url should be the path you access EndPoint Monitor "+
+				"through with /api appended.\n\n"+err.Error(),
+		)
+	case errors.As(err, &opError):
+		diags.AddAttributeError(
+			path.Root("url"),
+			"Unable to Connect to EndPointMonitor",
+			"The provider could not connect to the EndPointMonitor API at "+url+".\n\n"+err.Error(),
+		)
+	default:
+		diags.AddError(
+			"EndPointMonitor Health Check Failed",
+			fmt.Sprintf("The provider could not confirm the EndPointMonitor API at %s is usable. "+
+				"Set skip_health_check to skip this check.\n\n%s", url, err.Error()),
+		)
+	}
+}
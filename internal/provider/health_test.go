@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+// testHealthCheck runs the health check against url, returning the diagnostics it
+// gives, if any.
+func testHealthCheck(t *testing.T, url string, key string) (*EndPointMonitorClient, diag.Diagnostics) {
+	t.Helper()
+
+	client, err := NewEPMClient(EndPointMonitorClientConfig{HostURL: url, ApiKey: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var diags diag.Diagnostics
+	if err := client.CheckHealth(context.Background()); err != nil {
+		addHealthCheckDiagnostics(&diags, url, err)
+	}
+
+	return client, diags
+}
+
+// testClosedURL returns an API URL nothing is listening on.
+func testClosedURL(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	url := "http://" + listener.Addr().String() + "/api"
+	listener.Close()
+
+	return url
+}
+
+func TestAddHealthCheckDiagnostics(t *testing.T) {
+	tests := map[string]struct {
+		url         func(t *testing.T, server *fakeepm.Server) string
+		key         string
+		fail        []int
+		wantSummary string
+		wantPath    string
+	}{
+		"unresolvable host": {
+			url:         func(*testing.T, *fakeepm.Server) string { return "https://epm.invalid/api" },
+			wantSummary: "Unable to Resolve EndPointMonitor Host",
+			wantPath:    "url",
+		},
+		"unreachable": {
+			url:         func(t *testing.T, _ *fakeepm.Server) string { return testClosedURL(t) },
+			wantSummary: "Unable to Connect to EndPointMonitor",
+			wantPath:    "url",
+		},
+		"untrusted certificate": {
+			url: func(t *testing.T, _ *fakeepm.Server) string {
+				server := httptest.NewTLSServer(http.NotFoundHandler())
+				t.Cleanup(server.Close)
+
+				return server.URL + "/api"
+			},
+			wantSummary: "Unable to Verify EndPointMonitor TLS Certificate",
+			wantPath:    "url",
+		},
+		"https to a plain http server": {
+			url: func(_ *testing.T, server *fakeepm.Server) string {
+				return strings.Replace(server.APIURL(), "http://", "https://", 1)
+			},
+			wantSummary: "Unable to Connect to EndPointMonitor with TLS",
+			wantPath:    "url",
+		},
+		"wrong key": {
+			key:         "not-the-key",
+			wantSummary: "EndPointMonitor API Key Rejected",
+			wantPath:    "key",
+		},
+		"key without access": {
+			fail:        []int{http.StatusForbidden},
+			wantSummary: "EndPointMonitor API Key Not Permitted",
+			wantPath:    "key",
+		},
+		"api not found": {
+			url:         func(_ *testing.T, server *fakeepm.Server) string { return server.URL + "/epm" },
+			wantSummary: "EndPointMonitor API Not Found",
+			wantPath:    "url",
+		},
+		"server error": {
+			fail:        []int{http.StatusInternalServerError},
+			wantSummary: "EndPointMonitor Health Check Failed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := testAccFake(t)
+			server.Fail(test.fail...)
+
+			url := server.APIURL()
+			if test.url != nil {
+				url = test.url(t, server)
+			}
+
+			key := testAccKey
+			if test.key != "" {
+				key = test.key
+			}
+
+			_, diags := testHealthCheck(t, url, key)
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, want 1: %v", len(diags), diags)
+			}
+
+			if summary := diags[0].Summary(); summary != test.wantSummary {
+				t.Errorf("got %q, want %q", summary, test.wantSummary)
+			}
+
+			if got := testDiagnosticPaths(diags); got[0] != test.wantPath {
+				t.Errorf("got diagnostic at %q, want %q", got[0], test.wantPath)
+			}
+		})
+	}
+}
+
+func TestCheckHealthServerInfo(t *testing.T) {
+	server := testAccFake(t)
+
+	// Versions of EPM that don't have /info are still healthy.
+	client, diags := testHealthCheck(t, server.APIURL(), testAccKey)
+	if diags.HasError() {
+		t.Fatalf("got %v for a server without /info", diags)
+	}
+
+	if client.ServerInfo != nil {
+		t.Errorf("got server info %v from a server without /info", client.ServerInfo)
+	}
+
+	server.SetInfo("5.2.0", []string{"webJourneyAction:CLICK"})
+
+	client, diags = testHealthCheck(t, server.APIURL(), testAccKey)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if client.ServerInfo == nil || client.ServerInfo.Version != "5.2.0" || len(client.ServerInfo.Capabilities) != 1 {
+		t.Errorf("got server info %v, want what the server reported", client.ServerInfo)
+	}
+
+	// /info doesn't stand in for an authenticated request.
+	if _, diags := testHealthCheck(t, server.APIURL(), "not-the-key"); len(diags) != 1 || diags[0].Summary() != "EndPointMonitor API Key Rejected" {
+		t.Errorf("got %v for the wrong key, want it rejected", diags)
+	}
+}
@@ -41,3 +41,15 @@ func mapToHostGroupModel(hostGroup HostGroup) HostGroupModel {
 
 	return hostGroupModel
 }
+
+func mapToHostGroupSummaryModel(hostGroup HostGroup) HostGroupSummaryModel {
+	hostGroupModel := mapToHostGroupModel(hostGroup)
+
+	return HostGroupSummaryModel{
+		Id:          hostGroupModel.Id,
+		Name:        hostGroupModel.Name,
+		Description: hostGroupModel.Description,
+		Enabled:     hostGroupModel.Enabled,
+		Hosts:       hostGroupModel.Hosts,
+	}
+}
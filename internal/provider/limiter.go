@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter is shared by every resource and data source using the same client,
+// so it caps the load put on the EPM controller regardless of Terraform's parallelism.
+type requestLimiter struct {
+	rate     *rate.Limiter
+	inFlight chan struct{}
+}
+
+// newRequestLimiter creates a limiter allowing requestsPerSecond requests to start
+// each second with at most maxConcurrent in flight at once. A value of zero or less
+// for either disables that limit.
+func newRequestLimiter(requestsPerSecond float64, maxConcurrent int) *requestLimiter {
+	l := requestLimiter{}
+
+	if requestsPerSecond > 0 {
+		burst := max(int(requestsPerSecond), 1)
+		l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+
+	if maxConcurrent > 0 {
+		l.inFlight = make(chan struct{}, maxConcurrent)
+	}
+
+	return &l
+}
+
+// acquire blocks until a request is allowed to start or the context is done. Every
+// successful call must be paired with a call to release.
+func (l *requestLimiter) acquire(ctx context.Context) error {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			l.release()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *requestLimiter) release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
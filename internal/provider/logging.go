@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-endpointmonitor/internal/redact"
+)
+
+// logSubsystem is the tflog subsystem API requests are logged under. Its level can be
+// set on its own with TF_LOG_PROVIDER_ENDPOINTMONITOR_CLIENT, otherwise it follows
+// TF_LOG_PROVIDER or TF_LOG.
+const logSubsystem = "client"
+
+const (
+	redactedValue = "***"
+
+	// maxLoggedBodySize caps how much of a body is logged, as web and Android
+	// journey checks can be very large.
+	maxLoggedBodySize = 64 * 1024
+	// maxLoggedApkSize caps how much of a base64 encoded APK is logged.
+	maxLoggedApkSize = 64
+)
+
+// newLogContext sets up the logging subsystem for API requests on the context, with
+// the API key masked wherever it appears.
+func (c *EndPointMonitorClient) newLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_ENDPOINTMONITOR", "CLIENT"))
+
+	if c.ApiKey != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, c.ApiKey)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, logSubsystem, c.ApiKey)
+	}
+
+	return ctx
+}
+
+// logLevelEnvVars are the environment variables the level of the logging subsystem is
+// taken from, most specific first, as Terraform and the framework read them.
+var logLevelEnvVars = []string{
+	"TF_LOG_PROVIDER_ENDPOINTMONITOR_CLIENT",
+	"TF_LOG_PROVIDER_ENDPOINTMONITOR",
+	"TF_LOG_PROVIDER",
+	"TF_LOG",
+}
+
+// logBodies reports whether the bodies of requests and responses would be logged. The
+// provider's logs are filtered by Terraform rather than the provider itself, so bodies
+// would otherwise be read and redacted for every request, only to be thrown away.
+func logBodies() bool {
+	for _, name := range logLevelEnvVars {
+		if level := os.Getenv(name); level != "" {
+			switch strings.ToUpper(level) {
+			case "TRACE", "DEBUG", "JSON":
+				return true
+			default:
+				return false
+			}
+		}
+	}
+
+	return false
+}
+
+func logRequest(req *http.Request, attempt int) {
+	fields := map[string]any{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"attempt": attempt + 1,
+		"headers": redactHeaders(req.Header),
+	}
+
+	if req.GetBody != nil && logBodies() {
+		if body, err := req.GetBody(); err == nil {
+			rb, _ := io.ReadAll(body)
+			body.Close()
+			fields["body"] = redactBody(rb)
+		}
+	}
+
+	tflog.SubsystemDebug(req.Context(), logSubsystem, "Sending EndPointMonitor API request", fields)
+}
+
+func logResponse(req *http.Request, res *http.Response, body []byte, err error, latency time.Duration) {
+	fields := map[string]any{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"latency": latency.String(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(req.Context(), logSubsystem, "EndPointMonitor API request failed", fields)
+		return
+	}
+
+	fields["status"] = res.StatusCode
+	if logBodies() {
+		fields["body"] = redactBody(body)
+	}
+
+	tflog.SubsystemDebug(req.Context(), logSubsystem, "Received EndPointMonitor API response", fields)
+}
+
+// redactHeaders returns the request headers for logging, without the value of any that
+// carry credentials.
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+
+	for name := range header {
+		if redact.Header(name) {
+			headers[name] = redactedValue
+		} else {
+			headers[name] = header.Get(name)
+		}
+	}
+
+	return headers
+}
+
+// redactBody returns a JSON body for logging with secrets, such as passwords and the
+// values of request headers sent by URL checks, masked and APKs truncated.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	body = truncateApk(body)
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return truncateForLog(string(body), maxLoggedBodySize)
+	}
+
+	rb, err := json.Marshal(redact.JSON(value, redactedValue))
+	if err != nil {
+		return truncateForLog(string(body), maxLoggedBodySize)
+	}
+
+	return truncateForLog(string(rb), maxLoggedBodySize)
+}
+
+func truncateForLog(value string, size int) string {
+	if len(value) <= size {
+		return value
+	}
+
+	return fmt.Sprintf("%s... (truncated, %d bytes)", value[:size], len(value))
+}
+
+// apkField is the name of the field holding the APK of an Android journey check.
+var apkField = []byte(`"apk"`)
+
+// truncateApk truncates the APK in a JSON body before it is decoded, so a body holding
+// one, which can be many megabytes, isn't decoded and encoded again in full just to be
+// logged. Base64 has no characters that need escaping in JSON, so the APK ends at the
+// next quote.
+func truncateApk(body []byte) []byte {
+	field := bytes.Index(body, apkField)
+	if field < 0 {
+		return body
+	}
+
+	rest := bytes.TrimLeft(body[field+len(apkField):], " \t\r\n")
+	rest, found := bytes.CutPrefix(rest, []byte(":"))
+	rest = bytes.TrimLeft(rest, " \t\r\n")
+	if !found || !bytes.HasPrefix(rest, []byte(`"`)) {
+		return body
+	}
+
+	start := len(body) - len(rest) + 1
+
+	length := bytes.IndexByte(body[start:], '"')
+	if length <= maxLoggedApkSize {
+		return body
+	}
+
+	truncated := append([]byte{}, body[:start]...)
+	truncated = append(truncated, truncateForLog(string(body[start:start+length]), maxLoggedApkSize)...)
+
+	return append(truncated, body[start+length:]...)
+}
@@ -66,3 +66,25 @@ func mapToMaintenancePeriodModel(maintenancePeriod MaintenancePeriod) Maintenanc
 
 	return maintenancePeriodModel
 }
+
+func mapToMaintenancePeriodSummaryModel(maintenancePeriod MaintenancePeriod) MaintenancePeriodSummaryModel {
+	maintenancePeriodModel := mapToMaintenancePeriodModel(maintenancePeriod)
+
+	summary := MaintenancePeriodSummaryModel{
+		Id:              maintenancePeriodModel.Id,
+		Description:     maintenancePeriodModel.Description,
+		Enabled:         maintenancePeriodModel.Enabled,
+		StartTime:       maintenancePeriodModel.StartTime,
+		EndTime:         maintenancePeriodModel.EndTime,
+		DayOfWeek:       maintenancePeriodModel.DayOfWeek,
+		Checks:          []types.Int32{},
+		CheckGroups:     []types.Int32{},
+		DashboardGroups: []types.Int32{},
+	}
+
+	summary.Checks = append(summary.Checks, maintenancePeriodModel.Checks...)
+	summary.CheckGroups = append(summary.CheckGroups, maintenancePeriodModel.CheckGroups...)
+	summary.DashboardGroups = append(summary.DashboardGroups, maintenancePeriodModel.DashboardGroups...)
+
+	return summary
+}
@@ -1,38 +1,44 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type CheckGroupModel struct {
-	Id             types.Int32  `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	DashboardGroup types.Int32  `tfsdk:"dashboard_group_id"`
+	Id             types.Int32    `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	DashboardGroup types.Int32    `tfsdk:"dashboard_group_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CheckCommonModel struct {
-	Id                  types.Int64  `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	Enabled             types.Bool   `tfsdk:"enabled"`
-	MaintenanceOverride types.Bool   `tfsdk:"maintenance_override"`
-	CheckType           types.String `tfsdk:"-"`
-	CheckFrequency      types.Int32  `tfsdk:"check_frequency"`
-	TriggerCount        types.Int32  `tfsdk:"trigger_count"`
-	ResultRetentionDays types.Int32  `tfsdk:"result_retention"`
-	CheckHostId         types.Int32  `tfsdk:"check_host_id"`
-	HostGroupId         types.Int32  `tfsdk:"check_host_group_id"`
-	CheckGroupId        types.Int32  `tfsdk:"check_group_id"`
-	ProxyHostId         types.Int32  `tfsdk:"proxy_host_id"`
+	Id                  types.Int64    `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	Enabled             types.Bool     `tfsdk:"enabled"`
+	MaintenanceOverride types.Bool     `tfsdk:"maintenance_override"`
+	CheckType           types.String   `tfsdk:"-"`
+	CheckFrequency      types.Int32    `tfsdk:"check_frequency"`
+	TriggerCount        types.Int32    `tfsdk:"trigger_count"`
+	ResultRetentionDays types.Int32    `tfsdk:"result_retention"`
+	CheckHostId         types.Int32    `tfsdk:"check_host_id"`
+	HostGroupId         types.Int32    `tfsdk:"check_host_group_id"`
+	CheckGroupId        types.Int32    `tfsdk:"check_group_id"`
+	ProxyHostId         types.Int32    `tfsdk:"proxy_host_id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CheckHostModel struct {
-	Id                  types.Int32  `tfsdk:"id"`
-	Hostname            types.String `tfsdk:"hostname"`
-	Description         types.String `tfsdk:"description"`
-	Type                types.String `tfsdk:"type"`
-	Enabled             types.Bool   `tfsdk:"enabled"`
-	MaxWebJourneyChecks types.Int32  `tfsdk:"max_checks"`
-	SendCheckFiles      types.Bool   `tfsdk:"send_check_files"`
+	Id                  types.Int32    `tfsdk:"id"`
+	Hostname            types.String   `tfsdk:"hostname"`
+	Description         types.String   `tfsdk:"description"`
+	Type                types.String   `tfsdk:"type"`
+	Enabled             types.Bool     `tfsdk:"enabled"`
+	MaxWebJourneyChecks types.Int32    `tfsdk:"max_checks"`
+	SendCheckFiles      types.Bool     `tfsdk:"send_check_files"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CertificateCheckModel struct {
@@ -45,9 +51,10 @@ type CertificateCheckModel struct {
 }
 
 type DashboardGroupModel struct {
-	Id          types.Int32  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	Id          types.Int32    `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type DnsCheckModel struct {
@@ -61,39 +68,31 @@ type GenericSingleDataSource struct {
 	Id     types.Int32  `tfsdk:"id"`
 }
 
-type GenericMultipleDataSource struct {
-	Search types.String  `tfsdk:"search"`
-	Ids    []types.Int32 `tfsdk:"ids"`
-}
-
 type GenericSingleDataSource64 struct {
 	Search types.String `tfsdk:"search"`
 	Id     types.Int64  `tfsdk:"id"`
 }
 
-type GenericMultipleDataSource64 struct {
-	Search types.String  `tfsdk:"search"`
-	Ids    []types.Int64 `tfsdk:"ids"`
-}
-
 type HostGroupModel struct {
-	Id          types.Int32   `tfsdk:"id"`
-	Name        types.String  `tfsdk:"name"`
-	Description types.String  `tfsdk:"description"`
-	Enabled     types.Bool    `tfsdk:"enabled"`
-	Hosts       []types.Int32 `tfsdk:"check_host_ids"`
+	Id          types.Int32    `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Enabled     types.Bool     `tfsdk:"enabled"`
+	Hosts       []types.Int32  `tfsdk:"check_host_ids"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type MaintenancePeriodModel struct {
-	Id              types.Int32   `tfsdk:"id"`
-	Description     types.String  `tfsdk:"description"`
-	Enabled         types.Bool    `tfsdk:"enabled"`
-	StartTime       types.String  `tfsdk:"start_time"`
-	EndTime         types.String  `tfsdk:"end_time"`
-	DayOfWeek       types.String  `tfsdk:"day_of_week"`
-	Checks          []types.Int32 `tfsdk:"check_ids"`
-	CheckGroups     []types.Int32 `tfsdk:"check_group_ids"`
-	DashboardGroups []types.Int32 `tfsdk:"dashboard_group_ids"`
+	Id              types.Int32    `tfsdk:"id"`
+	Description     types.String   `tfsdk:"description"`
+	Enabled         types.Bool     `tfsdk:"enabled"`
+	StartTime       types.String   `tfsdk:"start_time"`
+	EndTime         types.String   `tfsdk:"end_time"`
+	DayOfWeek       types.String   `tfsdk:"day_of_week"`
+	Checks          []types.Int32  `tfsdk:"check_ids"`
+	CheckGroups     []types.Int32  `tfsdk:"check_group_ids"`
+	DashboardGroups []types.Int32  `tfsdk:"dashboard_group_ids"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type PingCheckModel struct {
@@ -104,11 +103,12 @@ type PingCheckModel struct {
 }
 
 type ProxyHostModel struct {
-	Id          types.Int32  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Hostname    types.String `tfsdk:"hostname"`
-	Port        types.Int32  `tfsdk:"port"`
+	Id          types.Int32    `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Hostname    types.String   `tfsdk:"hostname"`
+	Port        types.Int32    `tfsdk:"port"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type SocketCheckModel struct {
@@ -170,6 +170,7 @@ type AndroidJourneyCommonStepModel struct {
 	WaitTime         types.Int32                   `tfsdk:"wait_time"`
 	StepChecks       []AndroidStepCheckModel       `tfsdk:"step_check"`
 	StepInteractions []AndroidStepInteractionModel `tfsdk:"step_interaction"`
+	Timeouts         timeouts.Value                `tfsdk:"timeouts"`
 }
 
 type AndroidStepCheckModel struct {
@@ -288,6 +289,7 @@ type WebJourneyCommonStepModel struct {
 	ConsoleMessageSuppressions []ConsoleMessageSuppressionModel `tfsdk:"console_message_suppression"`
 	NetworkSuppressions        []NetworkSuppressionModel        `tfsdk:"network_suppression"`
 	Actions                    []WebJourneyActionModel          `tfsdk:"action"`
+	Timeouts                   timeouts.Value                   `tfsdk:"timeouts"`
 }
 
 type WebJourneyPageCheckModel struct {
@@ -416,3 +418,266 @@ type WebJourneyActionTextInputModel struct {
 	ElementName types.String `tfsdk:"element_name"`
 	Xpath       types.String `tfsdk:"xpath"`
 }
+
+// CheckDataSourceModel is the configuration of a check of any type, as returned by the
+// check data source. Only the block for the check's type is set.
+type CheckDataSourceModel struct {
+	Search              types.String                  `tfsdk:"search"`
+	Id                  types.Int64                   `tfsdk:"id"`
+	Name                types.String                  `tfsdk:"name"`
+	CheckType           types.String                  `tfsdk:"check_type"`
+	Description         types.String                  `tfsdk:"description"`
+	Enabled             types.Bool                    `tfsdk:"enabled"`
+	MaintenanceOverride types.Bool                    `tfsdk:"maintenance_override"`
+	CheckFrequency      types.Int32                   `tfsdk:"check_frequency"`
+	TriggerCount        types.Int32                   `tfsdk:"trigger_count"`
+	ResultRetentionDays types.Int32                   `tfsdk:"result_retention"`
+	CheckHostId         types.Int32                   `tfsdk:"check_host_id"`
+	HostGroupId         types.Int32                   `tfsdk:"check_host_group_id"`
+	CheckGroupId        types.Int32                   `tfsdk:"check_group_id"`
+	ProxyHostId         types.Int32                   `tfsdk:"proxy_host_id"`
+	UrlCheck            *UrlCheckDataModel            `tfsdk:"url_check"`
+	DnsCheck            *DnsCheckDataModel            `tfsdk:"dns_check"`
+	PingCheck           *PingCheckDataModel           `tfsdk:"ping_check"`
+	SocketCheck         *SocketCheckDataModel         `tfsdk:"socket_check"`
+	CertificateCheck    *CertificateCheckDataModel    `tfsdk:"certificate_check"`
+	WebJourneyCheck     *WebJourneyCheckDataModel     `tfsdk:"web_journey_check"`
+	AndroidJourneyCheck *AndroidJourneyCheckDataModel `tfsdk:"android_journey_check"`
+}
+
+type UrlCheckDataModel struct {
+	URL                  types.String `tfsdk:"url"`
+	RequestMethod        types.String `tfsdk:"request_method"`
+	ExpectedResponseCode types.Int32  `tfsdk:"expected_response_code"`
+	WarningResponseTime  types.Int32  `tfsdk:"warning_response_time"`
+	AlertResponseTime    types.Int32  `tfsdk:"alert_response_time"`
+	Timeout              types.Int32  `tfsdk:"timeout"`
+	AllowRedirects       types.Bool   `tfsdk:"allow_redirects"`
+	RequestBody          types.String `tfsdk:"request_body"`
+	RequestHeader        []struct {
+		Name  types.String `tfsdk:"name"`
+		Value types.String `tfsdk:"value"`
+	} `tfsdk:"request_header"`
+	ResponseBodyCheck []struct {
+		String     types.String `tfsdk:"string"`
+		Comparator types.String `tfsdk:"comparator"`
+	} `tfsdk:"response_body_check"`
+}
+
+type DnsCheckDataModel struct {
+	Hostname          types.String   `tfsdk:"hostname"`
+	ExpectedAddresses []types.String `tfsdk:"expected_addresses"`
+}
+
+type PingCheckDataModel struct {
+	Hostname            types.String `tfsdk:"hostname"`
+	TimeoutTime         types.Int32  `tfsdk:"timeout_time"`
+	WarningResponseTime types.Int32  `tfsdk:"warning_response_time"`
+}
+
+type SocketCheckDataModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int32  `tfsdk:"port"`
+}
+
+type CertificateCheckDataModel struct {
+	Url                  types.String `tfsdk:"url"`
+	WarningDaysRemaining types.Int32  `tfsdk:"warning_days_remaining"`
+	AlertDaysRemaining   types.Int32  `tfsdk:"alert_days_remaining"`
+	CheckDateOnly        types.Bool   `tfsdk:"check_date_only"`
+	CheckFullChain       types.Bool   `tfsdk:"check_full_chain"`
+}
+
+type WebJourneyCheckDataModel struct {
+	StartUrl       types.String `tfsdk:"start_url"`
+	WindowHeight   types.Int32  `tfsdk:"window_height"`
+	WindowWidth    types.Int32  `tfsdk:"window_width"`
+	MonitorDomains []struct {
+		Domain            types.String `tfsdk:"domain"`
+		IncludeSubDomains types.Bool   `tfsdk:"include_sub_domains"`
+	} `tfsdk:"monitor_domain"`
+	Steps []JourneyStepDataModel `tfsdk:"step"`
+}
+
+type AndroidJourneyCheckDataModel struct {
+	ApkChecksum          types.String           `tfsdk:"apk_checksum"`
+	ScreenOrientation    types.String           `tfsdk:"screen_orientation"`
+	OverridePackageName  types.String           `tfsdk:"override_package_name"`
+	OverrideMainActivity types.String           `tfsdk:"override_main_activity"`
+	Steps                []JourneyStepDataModel `tfsdk:"step"`
+}
+
+// JourneyStepDataModel outlines a step of a journey check and the checks and actions
+// that make it up, without the settings specific to the type of each.
+type JourneyStepDataModel struct {
+	Id           types.Int64                 `tfsdk:"id"`
+	Sequence     types.Int32                 `tfsdk:"sequence"`
+	Type         types.String                `tfsdk:"type"`
+	Name         types.String                `tfsdk:"name"`
+	CommonStepId types.Int64                 `tfsdk:"common_step_id"`
+	PageChecks   []JourneyPageCheckDataModel `tfsdk:"page_check"`
+	Actions      []JourneyActionDataModel    `tfsdk:"action"`
+}
+
+type JourneyPageCheckDataModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	WarningOnly types.Bool   `tfsdk:"warning_only"`
+}
+
+type JourneyActionDataModel struct {
+	Id             types.Int64  `tfsdk:"id"`
+	Sequence       types.Int32  `tfsdk:"sequence"`
+	Type           types.String `tfsdk:"type"`
+	Description    types.String `tfsdk:"description"`
+	AlwaysRequired types.Bool   `tfsdk:"always_required"`
+	WaitTime       types.Int32  `tfsdk:"wait_time"`
+}
+
+// The models of the plural data sources. Each returns the ids of the items found along
+// with a summary of each item, from what the API returns when searching.
+
+type ChecksDataSourceModel struct {
+	Search           types.String        `tfsdk:"search"`
+	Limit            types.Int64         `tfsdk:"limit"`
+	NameRegex        types.String        `tfsdk:"name_regex"`
+	CheckType        types.String        `tfsdk:"check_type"`
+	Enabled          types.Bool          `tfsdk:"enabled"`
+	CheckGroupId     types.Int32         `tfsdk:"check_group_id"`
+	DashboardGroupId types.Int32         `tfsdk:"dashboard_group_id"`
+	CheckHostId      types.Int32         `tfsdk:"check_host_id"`
+	HostGroupId      types.Int32         `tfsdk:"check_host_group_id"`
+	Ids              []types.Int64       `tfsdk:"ids"`
+	Checks           []CheckSummaryModel `tfsdk:"checks"`
+}
+
+type CheckSummaryModel struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	CheckType        types.String `tfsdk:"check_type"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	CheckFrequency   types.Int32  `tfsdk:"check_frequency"`
+	CheckGroupId     types.Int32  `tfsdk:"check_group_id"`
+	DashboardGroupId types.Int32  `tfsdk:"dashboard_group_id"`
+	CheckHostId      types.Int32  `tfsdk:"check_host_id"`
+	HostGroupId      types.Int32  `tfsdk:"check_host_group_id"`
+	ProxyHostId      types.Int32  `tfsdk:"proxy_host_id"`
+}
+
+type CheckGroupsDataSourceModel struct {
+	Search           types.String             `tfsdk:"search"`
+	Limit            types.Int64              `tfsdk:"limit"`
+	NameRegex        types.String             `tfsdk:"name_regex"`
+	DashboardGroupId types.Int32              `tfsdk:"dashboard_group_id"`
+	Ids              []types.Int32            `tfsdk:"ids"`
+	CheckGroups      []CheckGroupSummaryModel `tfsdk:"check_groups"`
+}
+
+type CheckGroupSummaryModel struct {
+	Id               types.Int32  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	DashboardGroupId types.Int32  `tfsdk:"dashboard_group_id"`
+}
+
+type CheckHostsDataSourceModel struct {
+	Search     types.String            `tfsdk:"search"`
+	Limit      types.Int64             `tfsdk:"limit"`
+	NameRegex  types.String            `tfsdk:"name_regex"`
+	Type       types.String            `tfsdk:"type"`
+	Enabled    types.Bool              `tfsdk:"enabled"`
+	Ids        []types.Int32           `tfsdk:"ids"`
+	CheckHosts []CheckHostSummaryModel `tfsdk:"check_hosts"`
+}
+
+type CheckHostSummaryModel struct {
+	Id          types.Int32  `tfsdk:"id"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+type HostGroupsDataSourceModel struct {
+	Search      types.String            `tfsdk:"search"`
+	Limit       types.Int64             `tfsdk:"limit"`
+	NameRegex   types.String            `tfsdk:"name_regex"`
+	Enabled     types.Bool              `tfsdk:"enabled"`
+	CheckHostId types.Int32             `tfsdk:"check_host_id"`
+	Ids         []types.Int32           `tfsdk:"ids"`
+	HostGroups  []HostGroupSummaryModel `tfsdk:"host_groups"`
+}
+
+type HostGroupSummaryModel struct {
+	Id          types.Int32   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	Enabled     types.Bool    `tfsdk:"enabled"`
+	Hosts       []types.Int32 `tfsdk:"check_host_ids"`
+}
+
+type MaintenancePeriodsDataSourceModel struct {
+	Search             types.String                    `tfsdk:"search"`
+	Limit              types.Int64                     `tfsdk:"limit"`
+	Enabled            types.Bool                      `tfsdk:"enabled"`
+	CheckId            types.Int32                     `tfsdk:"check_id"`
+	CheckGroupId       types.Int32                     `tfsdk:"check_group_id"`
+	DashboardGroupId   types.Int32                     `tfsdk:"dashboard_group_id"`
+	Ids                []types.Int32                   `tfsdk:"ids"`
+	MaintenancePeriods []MaintenancePeriodSummaryModel `tfsdk:"maintenance_periods"`
+}
+
+type MaintenancePeriodSummaryModel struct {
+	Id              types.Int32   `tfsdk:"id"`
+	Description     types.String  `tfsdk:"description"`
+	Enabled         types.Bool    `tfsdk:"enabled"`
+	StartTime       types.String  `tfsdk:"start_time"`
+	EndTime         types.String  `tfsdk:"end_time"`
+	DayOfWeek       types.String  `tfsdk:"day_of_week"`
+	Checks          []types.Int32 `tfsdk:"check_ids"`
+	CheckGroups     []types.Int32 `tfsdk:"check_group_ids"`
+	DashboardGroups []types.Int32 `tfsdk:"dashboard_group_ids"`
+}
+
+type ProxyHostsDataSourceModel struct {
+	Search     types.String            `tfsdk:"search"`
+	Limit      types.Int64             `tfsdk:"limit"`
+	NameRegex  types.String            `tfsdk:"name_regex"`
+	Ids        []types.Int32           `tfsdk:"ids"`
+	ProxyHosts []ProxyHostSummaryModel `tfsdk:"proxy_hosts"`
+}
+
+type ProxyHostSummaryModel struct {
+	Id          types.Int32  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Port        types.Int32  `tfsdk:"port"`
+}
+
+type DashboardGroupsDataSourceModel struct {
+	Search          types.String            `tfsdk:"search"`
+	Limit           types.Int64             `tfsdk:"limit"`
+	NameRegex       types.String            `tfsdk:"name_regex"`
+	Ids             []types.Int32           `tfsdk:"ids"`
+	DashboardGroups []NamedItemSummaryModel `tfsdk:"dashboard_groups"`
+}
+
+// CommonStepsDataSourceModel is the model of both the Android and web journey common
+// steps data sources.
+type CommonStepsDataSourceModel struct {
+	Search      types.String            `tfsdk:"search"`
+	Limit       types.Int64             `tfsdk:"limit"`
+	NameRegex   types.String            `tfsdk:"name_regex"`
+	Ids         []types.Int32           `tfsdk:"ids"`
+	CommonSteps []NamedItemSummaryModel `tfsdk:"common_steps"`
+}
+
+// NamedItemSummaryModel summarises an item with only a name and description.
+type NamedItemSummaryModel struct {
+	Id          types.Int32  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
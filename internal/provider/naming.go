@@ -0,0 +1,52 @@
+package provider
+
+import "strings"
+
+// Naming holds the changes the provider makes to the names and descriptions of the
+// items it creates, so items from different environments can be told apart when they
+// share an EPM installation.
+type Naming struct {
+	// NamePrefix and NameSuffix are added to the name of each item.
+	NamePrefix string
+	NameSuffix string
+	// ManagedBy is a marker added to the end of the description of each item.
+	ManagedBy string
+}
+
+// stamp adds the prefix, suffix and marker to a name and description about to be sent
+// to the API. Either may be nil for items without one.
+func (n Naming) stamp(name *string, description *string) {
+	if name != nil {
+		*name = n.NamePrefix + *name + n.NameSuffix
+	}
+
+	if description != nil && n.ManagedBy != "" {
+		if *description == "" {
+			*description = n.managedByMarker()
+		} else {
+			*description += " " + n.managedByMarker()
+		}
+	}
+}
+
+// strip removes the prefix, suffix and marker from a name and description read from
+// the API, so they match the configuration they were created from. Anything not
+// present is left as it is, such as for items created before they were set.
+func (n Naming) strip(name *string, description *string) {
+	if name != nil {
+		*name = strings.TrimPrefix(*name, n.NamePrefix)
+		*name = strings.TrimSuffix(*name, n.NameSuffix)
+	}
+
+	if description != nil && n.ManagedBy != "" {
+		if *description == n.managedByMarker() {
+			*description = ""
+		} else {
+			*description = strings.TrimSuffix(*description, " "+n.managedByMarker())
+		}
+	}
+}
+
+func (n Naming) managedByMarker() string {
+	return "[" + n.ManagedBy + "]"
+}
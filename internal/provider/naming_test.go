@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccNaming(t *testing.T) {
+	server := testAccFake(t)
+
+	// An item created before the provider was given its naming settings.
+	legacy := server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Legacy", "description": "Legacy dashboard."})
+
+	config := func(resources string) string {
+		return fmt.Sprintf(`
+provider "endpointmonitor" {
+  url         = %q
+  key         = %q
+  name_prefix = "prod-"
+  name_suffix = " (EU)"
+  managed_by  = "terraform"
+}
+
+resource "endpointmonitor_dashboard_group" "test" {
+  name        = "Operations"
+  description = "Operations dashboard."
+}
+%s`, server.APIURL(), testAccKey, resources)
+	}
+
+	legacyResource := func(description string) string {
+		return fmt.Sprintf(`
+resource "endpointmonitor_dashboard_group" "legacy" {
+  name        = "Legacy"
+  description = %q
+}
+`, description)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.DashboardGroups),
+		Steps: []resource.TestStep{
+			{
+				// Names and descriptions are stamped when sent, and stripped when read
+				// back, so they match the configuration.
+				Config: config(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_dashboard_group.test", "name", "Operations"),
+					resource.TestCheckResourceAttr("endpointmonitor_dashboard_group.test", "description", "Operations dashboard."),
+					testAccCheckStored(server, fakeepm.DashboardGroups, "endpointmonitor_dashboard_group.test", map[string]any{
+						"name":        "prod-Operations (EU)",
+						"description": "Operations dashboard. [terraform]",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_dashboard_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// An item without the prefix, suffix or marker is imported as it is.
+				Config:             config(legacyResource("Legacy dashboard.")),
+				ResourceName:       "endpointmonitor_dashboard_group.legacy",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprint(legacy),
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					for _, state := range states {
+						if state.ID != fmt.Sprint(legacy) {
+							continue
+						}
+
+						if state.Attributes["name"] != "Legacy" || state.Attributes["description"] != "Legacy dashboard." {
+							return fmt.Errorf("imported name %q and description %q, want them unchanged", state.Attributes["name"], state.Attributes["description"])
+						}
+
+						return nil
+					}
+
+					return fmt.Errorf("dashboard group %d not imported", legacy)
+				},
+			},
+			{
+				// Once imported, it matches the configuration, so is left as it is.
+				Config: config(legacyResource("Legacy dashboard.")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("endpointmonitor_dashboard_group.legacy", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: testAccCheckStored(server, fakeepm.DashboardGroups, "endpointmonitor_dashboard_group.legacy", map[string]any{
+					"name": "Legacy",
+				}),
+			},
+			{
+				// When it's next changed, it's stamped in turn.
+				Config: config(legacyResource("Legacy dashboard, now managed.")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_dashboard_group.legacy", "name", "Legacy"),
+					testAccCheckStored(server, fakeepm.DashboardGroups, "endpointmonitor_dashboard_group.legacy", map[string]any{
+						"name":        "prod-Legacy (EU)",
+						"description": "Legacy dashboard, now managed. [terraform]",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultProfile = "default"
+
+// defaultConfigFile returns the path of the shared config file used when none is
+// given, ~/.endpointmonitor/config.
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".endpointmonitor", "config")
+}
+
+// loadProfile reads the named profile from an INI style config file, such as:
+//
+//	[default]
+//	url = https://epm.dev.mydomain.com/api
+//	key_file = ~/.endpointmonitor/dev.key
+//
+//	[production]
+//	url = https://epm.mydomain.com/api
+//	credential_helper = vault-epm-token production
+//
+// A nil profile is returned without an error if the file doesn't exist and required
+// isn't set.
+func loadProfile(file string, name string, required bool) (map[string]string, error) {
+	f, err := os.Open(file)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config file: %w", err)
+	}
+	defer f.Close()
+
+	profiles := map[string]map[string]string{}
+	var section map[string]string
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			sectionName := strings.TrimSpace(text[1 : len(text)-1])
+			if profiles[sectionName] == nil {
+				profiles[sectionName] = map[string]string{}
+			}
+			section = profiles[sectionName]
+			continue
+		}
+
+		key, value, found := strings.Cut(text, "=")
+		if !found || section == nil {
+			return nil, fmt.Errorf("invalid line %d in config file %s, expected a [profile] or key = value", line, file)
+		}
+
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+
+		section[strings.TrimSpace(key)] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read config file: %w", err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		if !required {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("profile %q not found in config file %s", name, file)
+	}
+
+	return profile, nil
+}
+
+// applyProfile fills in any setting that isn't given in the configuration, or by its
+// environment variable, from the profile. Credentials from the profile are only used
+// if no other method of authentication has been set.
+func applyProfile(config *endPointMonitorProviderModel, profile map[string]string) error {
+	methods := 0
+	for _, key := range []string{"key", "key_file", "credential_helper", "oauth2_token_url"} {
+		if profile[key] != "" {
+			methods++
+		}
+	}
+
+	if methods > 1 {
+		return errors.New("only one of key, key_file, credential_helper or oauth2_token_url may be set in a profile")
+	}
+
+	useCredentials := config.Key.IsNull() && config.KeyFile.IsNull() && config.CredentialHelper.IsNull() && config.OAuth2 == nil &&
+		os.Getenv("EPM_API_KEY") == "" && os.Getenv("EPM_API_KEY_FILE") == "" && os.Getenv("EPM_CREDENTIAL_HELPER") == "" && os.Getenv("EPM_OAUTH2_TOKEN_URL") == ""
+
+	if useCredentials && (profile["oauth2_token_url"] != "" || profile["oauth2_client_id"] != "" || profile["oauth2_client_secret"] != "" || profile["oauth2_scopes"] != "") {
+		config.OAuth2 = &oauth2Model{
+			TokenURL:     types.StringNull(),
+			ClientID:     types.StringNull(),
+			ClientSecret: types.StringNull(),
+			Scopes:       types.ListNull(types.StringType),
+		}
+	}
+
+	for key, value := range profile {
+		var err error
+
+		switch key {
+		case "url":
+			setStringFromProfile(&config.Url, "EPM_URL", value)
+		case "key":
+			if useCredentials {
+				config.Key = types.StringValue(value)
+			}
+		case "key_file":
+			if useCredentials {
+				config.KeyFile = types.StringValue(expandHome(value))
+			}
+		case "credential_helper":
+			if useCredentials {
+				config.CredentialHelper = stringListValue(strings.Fields(value))
+			}
+		case "oauth2_token_url":
+			if useCredentials {
+				config.OAuth2.TokenURL = types.StringValue(value)
+			}
+		case "oauth2_client_id":
+			if useCredentials {
+				config.OAuth2.ClientID = types.StringValue(value)
+			}
+		case "oauth2_client_secret":
+			if useCredentials {
+				config.OAuth2.ClientSecret = types.StringValue(value)
+			}
+		case "oauth2_scopes":
+			if useCredentials {
+				config.OAuth2.Scopes = stringListValue(strings.Fields(value))
+			}
+		case "max_retries":
+			err = setInt32FromProfile(&config.MaxRetries, "EPM_MAX_RETRIES", value)
+		case "retry_max_wait":
+			err = setInt32FromProfile(&config.RetryMaxWait, "EPM_RETRY_MAX_WAIT", value)
+		case "requests_per_second":
+			if config.RequestsPerSecond.IsNull() && os.Getenv("EPM_REQUESTS_PER_SECOND") == "" {
+				var parsed float64
+				parsed, err = strconv.ParseFloat(value, 64)
+				config.RequestsPerSecond = types.Float64Value(parsed)
+			}
+		case "max_concurrent_requests":
+			err = setInt32FromProfile(&config.MaxConcurrentRequests, "EPM_MAX_CONCURRENT_REQUESTS", value)
+		case "ca_cert_file":
+			setStringFromProfile(&config.CACertFile, "EPM_CA_CERT_FILE", expandHome(value))
+		case "client_cert":
+			setStringFromProfile(&config.ClientCert, "EPM_CLIENT_CERT", expandHome(value))
+		case "client_key":
+			setStringFromProfile(&config.ClientKey, "EPM_CLIENT_KEY", expandHome(value))
+		case "insecure_skip_verify":
+			err = setBoolFromProfile(&config.InsecureSkipVerify, "EPM_INSECURE_SKIP_VERIFY", value)
+		case "skip_health_check":
+			err = setBoolFromProfile(&config.SkipHealthCheck, "EPM_SKIP_HEALTH_CHECK", value)
+		case "read_only":
+			err = setBoolFromProfile(&config.ReadOnly, "EPM_READ_ONLY", value)
+		case "name_prefix":
+			setStringFromProfile(&config.NamePrefix, "EPM_NAME_PREFIX", value)
+		case "name_suffix":
+			setStringFromProfile(&config.NameSuffix, "EPM_NAME_SUFFIX", value)
+		case "managed_by":
+			setStringFromProfile(&config.ManagedBy, "EPM_MANAGED_BY", value)
+		case "proxy_url":
+			setStringFromProfile(&config.ProxyURL, "EPM_PROXY_URL", value)
+		case "proxy_username":
+			setStringFromProfile(&config.ProxyUsername, "EPM_PROXY_USERNAME", value)
+		case "proxy_password":
+			setStringFromProfile(&config.ProxyPassword, "EPM_PROXY_PASSWORD", value)
+		case "no_proxy":
+			if config.NoProxy.IsNull() && os.Getenv("EPM_NO_PROXY") == "" {
+				hosts := []string{}
+				for _, host := range strings.Split(value, ",") {
+					hosts = append(hosts, strings.TrimSpace(host))
+				}
+				config.NoProxy = stringListValue(hosts)
+			}
+		case "ca_cert_pem":
+			return errors.New("ca_cert_pem can't be given in a profile as it spans several lines, use ca_cert_file instead")
+		case "config_file", "profile", "defaults":
+			return fmt.Errorf("%s can't be given in a profile", key)
+		default:
+			return fmt.Errorf("unknown setting %q in profile", key)
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid value for %s in profile: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func setStringFromProfile(value *types.String, env string, profileValue string) {
+	if value.IsNull() && os.Getenv(env) == "" {
+		*value = types.StringValue(profileValue)
+	}
+}
+
+func setBoolFromProfile(value *types.Bool, env string, profileValue string) error {
+	if !value.IsNull() || os.Getenv(env) != "" {
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(profileValue)
+	if err != nil {
+		return err
+	}
+
+	*value = types.BoolValue(parsed)
+	return nil
+}
+
+func setInt32FromProfile(value *types.Int32, env string, profileValue string) error {
+	if !value.IsNull() || os.Getenv(env) != "" {
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(profileValue, 10, 32)
+	if err != nil {
+		return err
+	}
+
+	*value = types.Int32Value(int32(parsed))
+	return nil
+}
+
+func stringListValue(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// expandHome expands a leading ~ in a path from the config file to the user's home
+// directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
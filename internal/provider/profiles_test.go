@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testConfigFile writes a shared config file for a test and returns its path.
+func testConfigFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(file, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+func TestLoadProfile(t *testing.T) {
+	const file = `
+# Settings for each installation.
+; Both kinds of comment are ignored.
+
+[default]
+url = https://epm.dev.mydomain.com/api
+key_file=~/.endpointmonitor/dev.key
+
+[ production ]
+url = "https://epm.mydomain.com/api"
+name_prefix = ' prod-'
+credential_helper = vault-epm-token production
+oauth2_scopes = "epm.read epm.write"
+no_proxy = a=b
+
+[default]
+read_only = true
+`
+
+	tests := map[string]struct {
+		contents string
+		name     string
+		required bool
+		want     map[string]string
+		wantErr  string
+	}{
+		"default": {
+			contents: file,
+			name:     "default",
+			want: map[string]string{
+				"url":       "https://epm.dev.mydomain.com/api",
+				"key_file":  "~/.endpointmonitor/dev.key",
+				"read_only": "true",
+			},
+		},
+		"quoted values": {
+			contents: file,
+			name:     "production",
+			required: true,
+			want: map[string]string{
+				"url":               "https://epm.mydomain.com/api",
+				"name_prefix":       " prod-",
+				"credential_helper": "vault-epm-token production",
+				"oauth2_scopes":     "epm.read epm.write",
+				"no_proxy":          "a=b",
+			},
+		},
+		"missing optional profile": {
+			contents: file,
+			name:     "staging",
+		},
+		"missing required profile": {
+			contents: file,
+			name:     "staging",
+			required: true,
+			wantErr:  `profile "staging" not found`,
+		},
+		"setting before a profile": {
+			contents: "url = https://epm.mydomain.com/api\n[default]\n",
+			name:     "default",
+			wantErr:  "invalid line 1",
+		},
+		"line without a value": {
+			contents: "[default]\nurl = https://epm.mydomain.com/api\nread_only\n",
+			name:     "default",
+			wantErr:  "invalid line 3",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := loadProfile(testConfigFile(t, test.contents), test.name, test.required)
+
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLoadProfileMissingFile(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "config")
+
+	// The default config file is optional.
+	profile, err := loadProfile(missing, defaultProfile, false)
+	if profile != nil || err != nil {
+		t.Errorf("got %v, %v for a missing optional file, want nothing", profile, err)
+	}
+
+	// A config file that was asked for must exist.
+	if _, err := loadProfile(missing, defaultProfile, true); err == nil || !strings.Contains(err.Error(), "unable to read config file") {
+		t.Errorf("got %v for a missing required file", err)
+	}
+}
+
+func TestApplyProfileRejects(t *testing.T) {
+	tests := map[string]struct {
+		profile map[string]string
+		wantErr string
+	}{
+		"unknown setting":     {profile: map[string]string{"hostname": "epm"}, wantErr: `unknown setting "hostname"`},
+		"ca_cert_pem":         {profile: map[string]string{"ca_cert_pem": "-----BEGIN"}, wantErr: "use ca_cert_file instead"},
+		"profile":             {profile: map[string]string{"profile": "production"}, wantErr: "profile can't be given in a profile"},
+		"invalid bool":        {profile: map[string]string{"read_only": "sometimes"}, wantErr: "invalid value for read_only"},
+		"invalid number":      {profile: map[string]string{"max_retries": "three"}, wantErr: "invalid value for max_retries"},
+		"several credentials": {profile: map[string]string{"key": "a", "key_file": "b"}, wantErr: "only one of key"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := endPointMonitorProviderModel{}
+
+			if err := applyProfile(&config, test.profile); err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("got %v, want %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+// TestApplyProfilePrecedence checks a setting in the configuration wins over its
+// environment variable, which wins over the profile.
+func TestApplyProfilePrecedence(t *testing.T) {
+	profile := map[string]string{
+		"url":         "https://profile.mydomain.com/api",
+		"max_retries": "7",
+		"read_only":   "true",
+		"key":         "profile-key",
+	}
+
+	tests := map[string]struct {
+		config        endPointMonitorProviderModel
+		env           map[string]string
+		wantURL       string
+		wantRetries   int32
+		wantReadOnly  bool
+		wantKey       string
+		wantKeyInConf bool
+	}{
+		"profile only": {
+			wantURL:       "https://profile.mydomain.com/api",
+			wantRetries:   7,
+			wantReadOnly:  true,
+			wantKey:       "profile-key",
+			wantKeyInConf: true,
+		},
+		"environment over profile": {
+			env: map[string]string{
+				"EPM_URL":         "https://env.mydomain.com/api",
+				"EPM_MAX_RETRIES": "2",
+				"EPM_READ_ONLY":   "false",
+				"EPM_API_KEY":     "env-key",
+			},
+			wantURL:     "https://env.mydomain.com/api",
+			wantRetries: 2,
+			wantKey:     "env-key",
+		},
+		"configuration over environment and profile": {
+			config: endPointMonitorProviderModel{
+				Url:        types.StringValue("https://config.mydomain.com/api"),
+				MaxRetries: types.Int32Value(0),
+				ReadOnly:   types.BoolValue(false),
+				Key:        types.StringValue("config-key"),
+			},
+			env: map[string]string{
+				"EPM_URL":         "https://env.mydomain.com/api",
+				"EPM_MAX_RETRIES": "2",
+				"EPM_API_KEY":     "env-key",
+			},
+			wantURL:       "https://config.mydomain.com/api",
+			wantRetries:   0,
+			wantKey:       "config-key",
+			wantKeyInConf: true,
+		},
+		"other credentials over the profile's": {
+			config: endPointMonitorProviderModel{
+				KeyFile: types.StringValue("/etc/epm.key"),
+			},
+			wantURL:      "https://profile.mydomain.com/api",
+			wantRetries:  7,
+			wantReadOnly: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{"EPM_URL", "EPM_MAX_RETRIES", "EPM_READ_ONLY", "EPM_API_KEY", "EPM_API_KEY_FILE", "EPM_CREDENTIAL_HELPER", "EPM_OAUTH2_TOKEN_URL"} {
+				t.Setenv(env, test.env[env])
+			}
+
+			config := test.config
+			if err := applyProfile(&config, profile); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := stringValueOrEnv(config.Url, "EPM_URL"); got != test.wantURL {
+				t.Errorf("got url %q, want %q", got, test.wantURL)
+			}
+
+			if got, _ := int32ValueOrEnv(config.MaxRetries, "EPM_MAX_RETRIES", defaultMaxRetries); got != test.wantRetries {
+				t.Errorf("got max_retries %d, want %d", got, test.wantRetries)
+			}
+
+			if got, _ := boolValueOrEnv(config.ReadOnly, "EPM_READ_ONLY", false); got != test.wantReadOnly {
+				t.Errorf("got read_only %t, want %t", got, test.wantReadOnly)
+			}
+
+			if got := stringValueOrEnv(config.Key, "EPM_API_KEY"); got != test.wantKey {
+				t.Errorf("got key %q, want %q", got, test.wantKey)
+			}
+
+			if inConf := !config.Key.IsNull(); inConf != test.wantKeyInConf {
+				t.Errorf("key set in the configuration is %t, want %t", inConf, test.wantKeyInConf)
+			}
+		})
+	}
+}
@@ -2,20 +2,32 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &endPointMonitorProvider{}
+	_ provider.Provider                     = &endPointMonitorProvider{}
+	_ provider.ProviderWithConfigValidators = &endPointMonitorProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -28,8 +40,39 @@ func New(version string) func() provider.Provider {
 }
 
 type endPointMonitorProviderModel struct {
-	Url types.String `tfsdk:"url"`
-	Key types.String `tfsdk:"key"`
+	Url                   types.String   `tfsdk:"url"`
+	Key                   types.String   `tfsdk:"key"`
+	MaxRetries            types.Int32    `tfsdk:"max_retries"`
+	RetryMaxWait          types.Int32    `tfsdk:"retry_max_wait"`
+	RequestsPerSecond     types.Float64  `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int32    `tfsdk:"max_concurrent_requests"`
+	CACertFile            types.String   `tfsdk:"ca_cert_file"`
+	CACertPEM             types.String   `tfsdk:"ca_cert_pem"`
+	ClientCert            types.String   `tfsdk:"client_cert"`
+	ClientKey             types.String   `tfsdk:"client_key"`
+	InsecureSkipVerify    types.Bool     `tfsdk:"insecure_skip_verify"`
+	ProxyURL              types.String   `tfsdk:"proxy_url"`
+	ProxyUsername         types.String   `tfsdk:"proxy_username"`
+	ProxyPassword         types.String   `tfsdk:"proxy_password"`
+	NoProxy               types.List     `tfsdk:"no_proxy"`
+	KeyFile               types.String   `tfsdk:"key_file"`
+	CredentialHelper      types.List     `tfsdk:"credential_helper"`
+	OAuth2                *oauth2Model   `tfsdk:"oauth2"`
+	ConfigFile            types.String   `tfsdk:"config_file"`
+	Profile               types.String   `tfsdk:"profile"`
+	SkipHealthCheck       types.Bool     `tfsdk:"skip_health_check"`
+	Defaults              *CheckDefaults `tfsdk:"defaults"`
+	NamePrefix            types.String   `tfsdk:"name_prefix"`
+	NameSuffix            types.String   `tfsdk:"name_suffix"`
+	ManagedBy             types.String   `tfsdk:"managed_by"`
+	ReadOnly              types.Bool     `tfsdk:"read_only"`
+}
+
+type oauth2Model struct {
+	TokenURL     types.String `tfsdk:"token_url"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
 }
 
 type endPointMonitorProvider struct {
@@ -37,6 +80,10 @@ type endPointMonitorProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// cassettes are recorded by the clients the provider configures, and written by
+	// CloseCassettes.
+	cassettes cassetteRecorders
 }
 
 // Metadata returns the provider type name.
@@ -56,6 +103,187 @@ func (p *endPointMonitorProvider) Schema(_ context.Context, _ provider.SchemaReq
 				Optional:  true,
 				Sensitive: true,
 			},
+			"max_retries": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The maximum number of times a request will be retried after a transient failure (HTTP 429, 502, 503, 504 or a dropped connection). Requests that add items are only retried when EndPoint Monitor can't have acted on them, after a refused connection or an HTTP 429 or 503 with a Retry-After header. Defaults to 3. Set to 0 to disable retries. This can also be passed in through the environment variable EPM_MAX_RETRIES.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The maximum number of seconds to wait between retries, including any wait requested by the server through a Retry-After header. Defaults to 30. This can also be passed in through the environment variable EPM_RETRY_MAX_WAIT.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests per second the provider will send to the EndPoint Monitor API, shared across all resources and data sources. Defaults to no limit. This can also be passed in through the environment variable EPM_REQUESTS_PER_SECOND.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests the provider will have in flight to the EndPoint Monitor API at any one time, regardless of Terraform's parallelism setting. Defaults to no limit. This can also be passed in through the environment variable EPM_MAX_CONCURRENT_REQUESTS.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM encoded CA certificate bundle to trust when connecting to the EndPoint Monitor API, in addition to the system trust store. Useful when your installation uses a certificate issued by an internal CA. This can also be passed in through the environment variable EPM_CA_CERT_FILE.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA certificates to trust when connecting to the EndPoint Monitor API, in addition to the system trust store. This can also be passed in through the environment variable EPM_CA_CERT_PEM.",
+			},
+			"client_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "A PEM encoded client certificate, or a path to one, to present to the EndPoint Monitor API for mutual TLS. Must be used with client_key. This can also be passed in through the environment variable EPM_CLIENT_CERT.",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key, or a path to one, for the certificate given in client_cert. This can also be passed in through the environment variable EPM_CLIENT_KEY.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Disables verification of the EndPoint Monitor API's TLS certificate. This should only be used for testing in lab environments. Defaults to false. This can also be passed in through the environment variable EPM_INSECURE_SKIP_VERIFY.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL of an HTTP proxy to send the provider's own requests to the EndPoint Monitor API through, such as http://proxy.mydomain.com:3128. If not set, the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used. This can also be passed in through the environment variable EPM_PROXY_URL.",
+			},
+			"proxy_username": schema.StringAttribute{
+				Optional:    true,
+				Description: "The username to authenticate with the proxy given in proxy_url, which must also be set. This can also be passed in through the environment variable EPM_PROXY_USERNAME.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy_url")),
+				},
+			},
+			"proxy_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password to authenticate with the proxy given in proxy_url, for proxy_username. Both must also be set. This can also be passed in through the environment variable EPM_PROXY_PASSWORD.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy_url"), path.MatchRoot("proxy_username")),
+				},
+			},
+			"no_proxy": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "A list of hosts, domains, IP addresses or CIDR ranges that should be connected to directly rather than through proxy_url, which must also be set. This can also be passed in through the environment variable EPM_NO_PROXY as a comma separated list.",
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("proxy_url")),
+				},
+			},
+			"key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing the API key to use, as an alternative to key. This can also be passed in through the environment variable EPM_API_KEY_FILE.",
+			},
+			"credential_helper": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "A command, followed by its arguments, that prints the credentials to use, as an alternative to key. The command can print an API key on its own, or a JSON object with either a `key` or a bearer `token`, and an optional `expires_at` time in RFC 3339 format. Credentials are reused until they expire. This can also be passed in through the environment variable EPM_CREDENTIAL_HELPER, with the command and its arguments separated by spaces.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"config_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a shared config file of named profiles, each of which can hold any of the settings of this provider. Defaults to ~/.endpointmonitor/config. Settings in the provider configuration, or their environment variables, override those from the profile. This can also be passed in through the environment variable EPM_CONFIG_FILE.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the profile in config_file to use. Defaults to default. This can also be passed in through the environment variable EPM_PROFILE.",
+			},
+			"skip_health_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skips checking the EndPoint Monitor API can be reached and accepts the credentials given when the provider is configured. This check also detects which features the server supports, so that configuration it would reject can be reported when planning. Defaults to false. This can also be passed in through the environment variable EPM_SKIP_HEALTH_CHECK.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Stops the provider making any change to EndPoint Monitor. Plans that would create, change or remove anything fail, so credentials without write access can be used to run plans, such as for audits or pull request checks. Defaults to false. This can also be passed in through the environment variable EPM_READ_ONLY.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Text added to the start of the name of every check, group, dashboard group and proxy host the provider manages, such as `prod-`. It is removed again when they are read, so it doesn't appear in the name attributes in your configuration. This can also be passed in through the environment variable EPM_NAME_PREFIX.",
+			},
+			"name_suffix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Text added to the end of the name of every check, group, dashboard group and proxy host the provider manages, such as ` (prod)`. It is removed again when they are read. This can also be passed in through the environment variable EPM_NAME_SUFFIX.",
+			},
+			"managed_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "A marker, such as `terraform:prod`, added in square brackets to the end of the description of every check, group, host and proxy host the provider manages. It is removed again when they are read. This can also be passed in through the environment variable EPM_MANAGED_BY.",
+			},
+			"defaults": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Values used for any check that doesn't set them itself.",
+				Attributes: map[string]schema.Attribute{
+					"check_group_id": schema.Int32Attribute{
+						Optional:    true,
+						Description: "The id of the Check Group checks belong to.",
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"check_host_group_id": schema.Int32Attribute{
+						Optional:    true,
+						Description: "The id of the Check Host Group to run checks on, for checks that don't set check_host_id either.",
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"proxy_host_id": schema.Int32Attribute{
+						Optional:    true,
+						Description: "The id of the Proxy Host checks should use for a HTTP proxy.",
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"trigger_count": schema.Int32Attribute{
+						Optional:    true,
+						Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification.",
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"result_retention": schema.Int32Attribute{
+						Optional:    true,
+						Description: "The number of days to store historic results of checks. Defaults to 366.",
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"oauth2": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Authenticate with bearer tokens fetched using the OAuth2 client credentials flow, as an alternative to key.",
+				Attributes: map[string]schema.Attribute{
+					"token_url": schema.StringAttribute{
+						Optional:    true,
+						Description: "The URL of the token endpoint to fetch tokens from. This can also be passed in through the environment variable EPM_OAUTH2_TOKEN_URL.",
+					},
+					"client_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "The client id to fetch tokens with. This can also be passed in through the environment variable EPM_OAUTH2_CLIENT_ID.",
+					},
+					"client_secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The client secret to fetch tokens with. This can also be passed in through the environment variable EPM_OAUTH2_CLIENT_SECRET.",
+					},
+					"scopes": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The scopes to request tokens for. This can also be passed in through the environment variable EPM_OAUTH2_SCOPES as a space separated list.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -96,18 +324,190 @@ func (p *endPointMonitorProvider) Configure(ctx context.Context, req provider.Co
 		return
 	}
 
+	// Fill in anything not set in the configuration or environment variables from
+	// the selected profile in the shared config file, if there is one.
+
+	configFile := stringValueOrEnv(config.ConfigFile, "EPM_CONFIG_FILE")
+	profileName := stringValueOrEnv(config.Profile, "EPM_PROFILE")
+	profileRequired := configFile != "" || profileName != ""
+
+	if configFile == "" {
+		configFile = defaultConfigFile()
+	}
+
+	if profileName == "" {
+		profileName = defaultProfile
+	}
+
+	profile, err := loadProfile(configFile, profileName, profileRequired)
+	if err == nil {
+		err = applyProfile(&config, profile)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Load EndPointMonitor Profile",
+			"The provider cannot load the "+profileName+" profile from "+configFile+": "+err.Error(),
+		)
+		return
+	}
+
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 
 	url := os.Getenv("EPM_URL")
-	key := os.Getenv("EPM_API_KEY")
 
 	if !config.Url.IsNull() {
 		url = config.Url.ValueString()
 	}
 
-	if !config.Key.IsNull() {
-		key = config.Key.ValueString()
+	credentials := resolveCredentials(ctx, config, &resp.Diagnostics)
+
+	maxRetries, err := int32ValueOrEnv(config.MaxRetries, "EPM_MAX_RETRIES", defaultMaxRetries)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid EndPointMonitor Max Retries",
+			"The EPM_MAX_RETRIES environment variable must be a whole number: "+err.Error(),
+		)
+	}
+
+	retryMaxWait, err := int32ValueOrEnv(config.RetryMaxWait, "EPM_RETRY_MAX_WAIT", int32(defaultRetryMaxWait.Seconds()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Invalid EndPointMonitor Retry Max Wait",
+			"The EPM_RETRY_MAX_WAIT environment variable must be a whole number of seconds: "+err.Error(),
+		)
+	}
+
+	requestsPerSecond, err := float64ValueOrEnv(config.RequestsPerSecond, "EPM_REQUESTS_PER_SECOND", 0)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid EndPointMonitor Requests Per Second",
+			"The EPM_REQUESTS_PER_SECOND environment variable must be a number: "+err.Error(),
+		)
+	}
+
+	maxConcurrentRequests, err := int32ValueOrEnv(config.MaxConcurrentRequests, "EPM_MAX_CONCURRENT_REQUESTS", 0)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid EndPointMonitor Max Concurrent Requests",
+			"The EPM_MAX_CONCURRENT_REQUESTS environment variable must be a whole number: "+err.Error(),
+		)
+	}
+
+	insecureSkipVerify, err := boolValueOrEnv(config.InsecureSkipVerify, "EPM_INSECURE_SKIP_VERIFY", false)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure_skip_verify"),
+			"Invalid EndPointMonitor Insecure Skip Verify",
+			"The EPM_INSECURE_SKIP_VERIFY environment variable must be true or false: "+err.Error(),
+		)
+	}
+
+	skipHealthCheck, err := boolValueOrEnv(config.SkipHealthCheck, "EPM_SKIP_HEALTH_CHECK", false)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("skip_health_check"),
+			"Invalid EndPointMonitor Skip Health Check",
+			"The EPM_SKIP_HEALTH_CHECK environment variable must be true or false: "+err.Error(),
+		)
+	}
+
+	readOnly, err := boolValueOrEnv(config.ReadOnly, "EPM_READ_ONLY", false)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Invalid EndPointMonitor Read Only",
+			"The EPM_READ_ONLY environment variable must be true or false: "+err.Error(),
+		)
+	}
+
+	checkDefaults := CheckDefaults{
+		CheckGroupId:    types.Int32Null(),
+		HostGroupId:     types.Int32Null(),
+		ProxyHostId:     types.Int32Null(),
+		TriggerCount:    types.Int32Null(),
+		ResultRetention: types.Int32Null(),
+	}
+	if config.Defaults != nil {
+		checkDefaults = *config.Defaults
+	}
+
+	noProxy := []string{}
+	if !config.NoProxy.IsNull() {
+		resp.Diagnostics.Append(config.NoProxy.ElementsAs(ctx, &noProxy, false)...)
+	} else if env := os.Getenv("EPM_NO_PROXY"); env != "" {
+		for _, host := range strings.Split(env, ",") {
+			noProxy = append(noProxy, strings.TrimSpace(host))
+		}
+	}
+
+	// Values from environment variables and the profile haven't been through the
+	// schema validators, so check the merged values against the same limits.
+
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid EndPointMonitor Max Retries",
+			fmt.Sprintf("max_retries must be at least 0, got %d from the EPM_MAX_RETRIES environment variable or the selected profile.", maxRetries),
+		)
+	}
+
+	if retryMaxWait < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Invalid EndPointMonitor Retry Max Wait",
+			fmt.Sprintf("retry_max_wait must be at least 1, got %d from the EPM_RETRY_MAX_WAIT environment variable or the selected profile.", retryMaxWait),
+		)
+	}
+
+	if requestsPerSecond < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid EndPointMonitor Requests Per Second",
+			fmt.Sprintf("requests_per_second must be at least 0, got %g from the EPM_REQUESTS_PER_SECOND environment variable or the selected profile.", requestsPerSecond),
+		)
+	}
+
+	proxyURL := stringValueOrEnv(config.ProxyURL, "EPM_PROXY_URL")
+	proxyUsername := stringValueOrEnv(config.ProxyUsername, "EPM_PROXY_USERNAME")
+	proxyPassword := stringValueOrEnv(config.ProxyPassword, "EPM_PROXY_PASSWORD")
+
+	if proxyURL == "" {
+		for _, setting := range []struct {
+			name string
+			set  bool
+		}{
+			{"proxy_username", proxyUsername != ""},
+			{"proxy_password", proxyPassword != ""},
+			{"no_proxy", len(noProxy) > 0},
+		} {
+			if setting.set {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(setting.name),
+					"Missing EndPointMonitor Proxy URL",
+					fmt.Sprintf("%s only applies to the proxy given in proxy_url, which isn't set in the configuration, the EPM_PROXY_URL environment variable or the selected profile.", setting.name),
+				)
+			}
+		}
+	} else if proxyPassword != "" && proxyUsername == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_password"),
+			"Missing EndPointMonitor Proxy Username",
+			"proxy_password is only sent with proxy_username, which isn't set in the configuration, the EPM_PROXY_USERNAME environment variable or the selected profile.",
+		)
+	}
+
+	if maxConcurrentRequests < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid EndPointMonitor Max Concurrent Requests",
+			fmt.Sprintf("max_concurrent_requests must be at least 0, got %d from the EPM_MAX_CONCURRENT_REQUESTS environment variable or the selected profile.", maxConcurrentRequests),
+		)
 	}
 
 	// If any of the expected configurations are missing, return
@@ -115,21 +515,22 @@ func (p *endPointMonitorProvider) Configure(ctx context.Context, req provider.Co
 
 	if url == "" {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("host"),
+			path.Root("url"),
 			"Missing EndPointMonitor URL",
-			"The provider cannot create the EndPointMonitor client as there is a missing or empty value for the EndPointMonitor API host. "+
-				"Set the host value in the configuration or use the EPM_URL environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			"The provider cannot create the EndPointMonitor client as there is a missing or empty value for the EndPointMonitor API URL. "+
+				"Set the url value in the configuration, use the EPM_URL environment variable or set url in the selected profile. "+
+				"If any are already set, ensure the value is not empty.",
 		)
 	}
 
-	if key == "" {
+	if credentials.key == "" && credentials.helper == nil && credentials.oauth2 == nil {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
+			path.Root("key"),
 			"Missing EndPointMonitor API Key",
 			"The provider cannot create the EndPointMonitor client as there is a missing or empty value for the EndPointMonitor API key. "+
-				"Set the username value in the configuration or use the EPM_API_KEY environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the key value in the configuration or use the EPM_API_KEY environment variable, "+
+				"or use one of key_file, credential_helper or oauth2 instead. "+
+				"If any are already set, ensure the value is not empty.",
 		)
 	}
 
@@ -138,13 +539,67 @@ func (p *endPointMonitorProvider) Configure(ctx context.Context, req provider.Co
 	}
 
 	ctx = tflog.SetField(ctx, "endpointmonitor_url", url)
-	ctx = tflog.SetField(ctx, "endpointmonitor_key", key)
+	ctx = tflog.SetField(ctx, "endpointmonitor_key", credentials.key)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "endpointmonitor_key")
 
+	if insecureSkipVerify {
+		resp.Diagnostics.AddWarning(
+			"EndPointMonitor TLS Verification Disabled",
+			"The provider will not verify the TLS certificate of the EndPointMonitor API. "+
+				"This should only be used in lab or test environments.",
+		)
+	}
+
+	recordCassette := os.Getenv("EPM_RECORD_CASSETTE")
+	replayCassette := os.Getenv("EPM_REPLAY_CASSETTE")
+
+	if recordCassette != "" {
+		resp.Diagnostics.AddWarning(
+			"EndPointMonitor API Traffic Being Recorded",
+			"Requests to the EndPointMonitor API and their responses are being recorded to "+recordCassette+". "+
+				"The file is written when Terraform stops the provider. Values that look like secrets are redacted, but check the file before sharing it.",
+		)
+	}
+
+	if replayCassette != "" {
+		resp.Diagnostics.AddWarning(
+			"EndPointMonitor API Traffic Being Replayed",
+			"Responses are being replayed from "+replayCassette+" rather than requested from the EndPointMonitor API, "+
+				"so nothing shown reflects the current state of EndPoint Monitor.",
+		)
+	}
+
 	tflog.Debug(ctx, "Creating EndPoint Monitor client")
 
 	// Create a new EPM client using the configuration values
-	client, err := NewEPMClient(url, &key)
+	client, err := NewEPMClient(EndPointMonitorClientConfig{
+		HostURL:               url,
+		ApiKey:                credentials.key,
+		MaxRetries:            int(maxRetries),
+		RetryMaxWait:          time.Duration(retryMaxWait) * time.Second,
+		RequestsPerSecond:     requestsPerSecond,
+		MaxConcurrentRequests: int(maxConcurrentRequests),
+		CACertFile:            stringValueOrEnv(config.CACertFile, "EPM_CA_CERT_FILE"),
+		CACertPEM:             stringValueOrEnv(config.CACertPEM, "EPM_CA_CERT_PEM"),
+		ClientCert:            stringValueOrEnv(config.ClientCert, "EPM_CLIENT_CERT"),
+		ClientKey:             stringValueOrEnv(config.ClientKey, "EPM_CLIENT_KEY"),
+		InsecureSkipVerify:    insecureSkipVerify,
+		ProxyURL:              proxyURL,
+		ProxyUsername:         proxyUsername,
+		ProxyPassword:         proxyPassword,
+		NoProxy:               noProxy,
+		CredentialHelper:      credentials.helper,
+		OAuth2:                credentials.oauth2,
+		CheckDefaults:         checkDefaults,
+		Naming: Naming{
+			NamePrefix: stringValueOrEnv(config.NamePrefix, "EPM_NAME_PREFIX"),
+			NameSuffix: stringValueOrEnv(config.NameSuffix, "EPM_NAME_SUFFIX"),
+			ManagedBy:  stringValueOrEnv(config.ManagedBy, "EPM_MANAGED_BY"),
+		},
+		ReadOnly:       readOnly,
+		RecordCassette: recordCassette,
+		ReplayCassette: replayCassette,
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create EndPointMonitor Client",
@@ -155,6 +610,23 @@ func (p *endPointMonitorProvider) Configure(ctx context.Context, req provider.Co
 		return
 	}
 
+	if client.recorder != nil {
+		p.cassettes.add(client.recorder)
+	}
+
+	if !skipHealthCheck {
+		tflog.Debug(ctx, "Checking EndPoint Monitor API health")
+
+		if err := client.CheckHealth(ctx); err != nil {
+			addHealthCheckDiagnostics(&resp.Diagnostics, url, err)
+			return
+		}
+
+		if client.ServerInfo != nil {
+			tflog.Info(ctx, "Connected to EndPoint Monitor", map[string]any{"server_version": client.ServerInfo.Version})
+		}
+	}
+
 	// Make the EndPointMonitor client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -163,6 +635,18 @@ func (p *endPointMonitorProvider) Configure(ctx context.Context, req provider.Co
 	tflog.Info(ctx, "Configured EndPointMonitor client", map[string]any{"success": true})
 }
 
+// ConfigValidators ensures only one method of authentication is configured.
+func (p *endPointMonitorProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("key"),
+			path.MatchRoot("key_file"),
+			path.MatchRoot("credential_helper"),
+			path.MatchRoot("oauth2"),
+		),
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *endPointMonitorProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -207,3 +691,163 @@ func (p *endPointMonitorProvider) Resources(_ context.Context) []func() resource
 		NewMaintenancePeriodResource,
 	}
 }
+
+// providerCredentials are the credentials for the one method of authentication in use.
+type providerCredentials struct {
+	key    string
+	helper []string
+	oauth2 *OAuth2Config
+}
+
+// resolveCredentials works out which method of authentication to use. A method set in
+// the configuration is always used, otherwise the environment variables are checked,
+// where only one method may be set.
+func resolveCredentials(ctx context.Context, config endPointMonitorProviderModel, diags *diag.Diagnostics) providerCredentials {
+	credentials := providerCredentials{}
+
+	useKey := !config.Key.IsNull()
+	useKeyFile := !config.KeyFile.IsNull()
+	useHelper := !config.CredentialHelper.IsNull()
+	useOAuth2 := config.OAuth2 != nil
+
+	if !useKey && !useKeyFile && !useHelper && !useOAuth2 {
+		useKey = os.Getenv("EPM_API_KEY") != ""
+		useKeyFile = os.Getenv("EPM_API_KEY_FILE") != ""
+		useHelper = os.Getenv("EPM_CREDENTIAL_HELPER") != ""
+		useOAuth2 = os.Getenv("EPM_OAUTH2_TOKEN_URL") != ""
+
+		set := 0
+		for _, use := range []bool{useKey, useKeyFile, useHelper, useOAuth2} {
+			if use {
+				set++
+			}
+		}
+
+		if set > 1 {
+			diags.AddError(
+				"Conflicting EndPointMonitor Credentials",
+				"Only one of the EPM_API_KEY, EPM_API_KEY_FILE, EPM_CREDENTIAL_HELPER and EPM_OAUTH2_TOKEN_URL environment variables may be set. "+
+					"Unset the others, or set the credentials to use in the provider configuration.",
+			)
+			return credentials
+		}
+	}
+
+	switch {
+	case useKey:
+		credentials.key = stringValueOrEnv(config.Key, "EPM_API_KEY")
+	case useKeyFile:
+		keyFile := stringValueOrEnv(config.KeyFile, "EPM_API_KEY_FILE")
+
+		key, err := os.ReadFile(keyFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("key_file"),
+				"Unable to Read EndPointMonitor API Key File",
+				"The provider cannot read the API key from "+keyFile+": "+err.Error(),
+			)
+			return credentials
+		}
+
+		credentials.key = strings.TrimSpace(string(key))
+	case useHelper:
+		if !config.CredentialHelper.IsNull() {
+			diags.Append(config.CredentialHelper.ElementsAs(ctx, &credentials.helper, false)...)
+		} else {
+			credentials.helper = strings.Fields(os.Getenv("EPM_CREDENTIAL_HELPER"))
+		}
+	case useOAuth2:
+		oauth2 := oauth2Model{
+			TokenURL:     types.StringNull(),
+			ClientID:     types.StringNull(),
+			ClientSecret: types.StringNull(),
+			Scopes:       types.ListNull(types.StringType),
+		}
+		if config.OAuth2 != nil {
+			oauth2 = *config.OAuth2
+		}
+
+		credentials.oauth2 = &OAuth2Config{
+			TokenURL:     stringValueOrEnv(oauth2.TokenURL, "EPM_OAUTH2_TOKEN_URL"),
+			ClientID:     stringValueOrEnv(oauth2.ClientID, "EPM_OAUTH2_CLIENT_ID"),
+			ClientSecret: stringValueOrEnv(oauth2.ClientSecret, "EPM_OAUTH2_CLIENT_SECRET"),
+		}
+
+		if !oauth2.Scopes.IsNull() {
+			diags.Append(oauth2.Scopes.ElementsAs(ctx, &credentials.oauth2.Scopes, false)...)
+		} else {
+			credentials.oauth2.Scopes = strings.Fields(os.Getenv("EPM_OAUTH2_SCOPES"))
+		}
+
+		if credentials.oauth2.TokenURL == "" || credentials.oauth2.ClientID == "" || credentials.oauth2.ClientSecret == "" {
+			diags.AddAttributeError(
+				path.Root("oauth2"),
+				"Incomplete EndPointMonitor OAuth2 Configuration",
+				"A token_url, client_id and client_secret must all be set to use OAuth2, either in the oauth2 block or through the "+
+					"EPM_OAUTH2_TOKEN_URL, EPM_OAUTH2_CLIENT_ID and EPM_OAUTH2_CLIENT_SECRET environment variables.",
+			)
+		}
+	}
+
+	return credentials
+}
+
+// stringValueOrEnv returns the configured value if set, otherwise the value of the
+// given environment variable.
+func stringValueOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// boolValueOrEnv returns the configured value if set, otherwise the value of the
+// given environment variable, falling back to def when neither is present.
+func boolValueOrEnv(value types.Bool, env string, def bool) (bool, error) {
+	if !value.IsNull() {
+		return value.ValueBool(), nil
+	}
+
+	raw := os.Getenv(env)
+	if raw == "" {
+		return def, nil
+	}
+
+	return strconv.ParseBool(raw)
+}
+
+// int32ValueOrEnv returns the configured value if set, otherwise the value of the
+// given environment variable, falling back to def when neither is present.
+func int32ValueOrEnv(value types.Int32, env string, def int32) (int32, error) {
+	if !value.IsNull() {
+		return value.ValueInt32(), nil
+	}
+
+	raw := os.Getenv(env)
+	if raw == "" {
+		return def, nil
+	}
+
+	parsed, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return def, err
+	}
+
+	return int32(parsed), nil
+}
+
+// float64ValueOrEnv returns the configured value if set, otherwise the value of the
+// given environment variable, falling back to def when neither is present.
+func float64ValueOrEnv(value types.Float64, env string, def float64) (float64, error) {
+	if !value.IsNull() {
+		return value.ValueFloat64(), nil
+	}
+
+	raw := os.Getenv(env)
+	if raw == "" {
+		return def, nil
+	}
+
+	return strconv.ParseFloat(raw, 64)
+}
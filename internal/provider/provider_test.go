@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate the provider during
+// acceptance testing, where Terraform is run against the fake EPM API. Acceptance
+// tests only run when TF_ACC is set.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"endpointmonitor": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+const testAccKey = "fake-key"
+
+// testAccFake starts a fake EPM API for a test, which is closed when the test ends.
+func testAccFake(t *testing.T) *fakeepm.Server {
+	t.Helper()
+
+	server := fakeepm.New(testAccKey)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testAccConfig returns a configuration of the provider for the fake, followed by
+// the rest of the configuration, formatted as by fmt.Sprintf.
+func testAccConfig(server *fakeepm.Server, config string, args ...any) string {
+	return fmt.Sprintf(`
+provider "endpointmonitor" {
+  url = %q
+  key = %q
+}
+`, server.APIURL(), testAccKey) + fmt.Sprintf(config, args...)
+}
+
+// testAccCheckStored checks the fake holds the item of a resource, with the given
+// values at the top level of the JSON sent to the API.
+func testAccCheckStored(server *fakeepm.Server, collection string, resourceName string, values map[string]any) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", resourceName)
+		}
+
+		var id int64
+		if _, err := fmt.Sscan(rs.Primary.ID, &id); err != nil {
+			return fmt.Errorf("%s has an invalid id %q", resourceName, rs.Primary.ID)
+		}
+
+		item, found := server.Get(collection, id)
+		if !found {
+			return fmt.Errorf("%s, id %d, not found in the fake", resourceName, id)
+		}
+
+		for key, want := range values {
+			if got := fmt.Sprint(item[key]); got != fmt.Sprint(want) {
+				return fmt.Errorf("%s, id %d, has %s %q in the fake, want %q", resourceName, id, key, got, fmt.Sprint(want))
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckRemoved checks the fake holds none of a collection's items, once they
+// have all been destroyed.
+func testAccCheckRemoved(server *fakeepm.Server, collection string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if items := server.Items(collection); len(items) > 0 {
+			return fmt.Errorf("%d items remain in %s after destroy", len(items), collection)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDependencies configures the items a check is run from, grouped in and
+// proxied through, for the tests of each type of check.
+const testAccCheckDependencies = `
+resource "endpointmonitor_dashboard_group" "test" {
+  name        = "Test Dashboard Group"
+  description = "Test dashboard group."
+}
+
+resource "endpointmonitor_check_group" "test" {
+  name               = "Test Check Group"
+  description        = "Test check group."
+  dashboard_group_id = endpointmonitor_dashboard_group.test.id
+}
+
+resource "endpointmonitor_check_host" "test" {
+  hostname    = "test-agent-01.mycompany.com"
+  description = "Test agent."
+  type        = "AGENT"
+  enabled     = true
+}
+
+resource "endpointmonitor_proxy_host" "test" {
+  name        = "Test Proxy Host"
+  description = "Test proxy host."
+  hostname    = "test-proxy-01.mycompany.com"
+  port        = 3128
+}
+`
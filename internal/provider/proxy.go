@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// buildProxyFunc returns the proxy selection function for the transport used to
+// talk to the EPM API. Without an explicit proxy URL the standard HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables are used, as before.
+func buildProxyFunc(config EndPointMonitorClientConfig) (func(*http.Request) (*url.URL, error), error) {
+	if config.ProxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxyURL, err := url.Parse(config.ProxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+
+	if proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: must include a scheme and host, such as http://proxy.example.com:3128", config.ProxyURL)
+	}
+
+	if config.ProxyUsername != "" {
+		// Credentials in the proxy URL are sent by the transport as a
+		// Proxy-Authorization header, including on CONNECT for HTTPS.
+		proxyURL.User = url.UserPassword(config.ProxyUsername, config.ProxyPassword)
+	}
+
+	proxyConfig := httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    strings.Join(config.NoProxy, ","),
+	}
+
+	proxyFunc := proxyConfig.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}, nil
+}
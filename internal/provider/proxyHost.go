@@ -29,3 +29,13 @@ func mapToProxyHostModel(proxyHost ProxyHost) ProxyHostModel {
 		Port:        types.Int32Value(int32(proxyHost.Port)),
 	}
 }
+
+func mapToProxyHostSummaryModel(proxyHost ProxyHost) ProxyHostSummaryModel {
+	return ProxyHostSummaryModel{
+		Id:          types.Int32Value(int32(proxyHost.Id)),
+		Name:        types.StringValue(proxyHost.Name),
+		Description: types.StringValue(proxyHost.Description),
+		Hostname:    types.StringValue(proxyHost.Hostname),
+		Port:        types.Int32Value(int32(proxyHost.Port)),
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+// testAccProxyConfig configures the provider for the fake with the given proxy
+// settings, and reads a dashboard group through it.
+func testAccProxyConfig(server *fakeepm.Server, settings string) string {
+	return fmt.Sprintf(`
+provider "endpointmonitor" {
+  url = %q
+  key = %q
+%s
+}
+
+data "endpointmonitor_dashboard_groups" "all" {}
+`, server.APIURL(), testAccKey, settings)
+}
+
+func TestAccProviderProxySettings(t *testing.T) {
+	server := testAccFake(t)
+	server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Operations", "description": "Operations dashboard."})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyConfig(server, `
+  no_proxy = ["127.0.0.1"]
+`),
+				ExpectError: regexp.MustCompile(`Attribute "proxy_url" must be specified when "no_proxy" is\s+specified`),
+			},
+			{
+				Config: testAccProxyConfig(server, `
+  proxy_url      = "http://proxy.invalid:3128"
+  proxy_password = "not-a-real-password"
+`),
+				ExpectError: regexp.MustCompile(`Attribute "proxy_username" must be specified when "proxy_password" is\s+specified`),
+			},
+			{
+				// The fake is excluded from the proxy, which doesn't exist.
+				Config: testAccProxyConfig(server, `
+  proxy_url = "http://proxy.invalid:3128"
+  no_proxy  = ["127.0.0.1"]
+`),
+				Check: resource.TestCheckResourceAttr("data.endpointmonitor_dashboard_groups.all", "ids.#", "1"),
+			},
+		},
+	})
+}
+
+func TestAccProviderProxySettingsFromEnvironment(t *testing.T) {
+	server := testAccFake(t)
+	server.Add(fakeepm.DashboardGroups, map[string]any{"name": "Operations", "description": "Operations dashboard."})
+
+	t.Setenv("EPM_PROXY_URL", "")
+	t.Setenv("EPM_PROXY_USERNAME", "proxy-user")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProxyConfig(server, ""),
+				ExpectError: regexp.MustCompile(`Missing EndPointMonitor Proxy URL`),
+			},
+		},
+	})
+}
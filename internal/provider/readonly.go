@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// checkReadOnly adds an error to a plan that would create, change or remove an item
+// when the client is read only, so the plan fails rather than the apply. It compares
+// the plan in the response, so resources defer it at the start of ModifyPlan, to have
+// it cover everything the rest of ModifyPlan changes.
+func (c *EndPointMonitorClient) checkReadOnly(req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !c.ReadOnly {
+		return
+	}
+
+	var action string
+
+	switch {
+	case resp.Plan.Raw.IsNull() && req.State.Raw.IsNull():
+		return
+	case resp.Plan.Raw.IsNull():
+		action = "removed"
+	case req.State.Raw.IsNull():
+		action = "created"
+	case !resp.Plan.Raw.Equal(req.State.Raw):
+		action = "changed"
+	default:
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Change Not Allowed in Read-Only Mode",
+		"This item would be "+action+", but the provider is read only and will not make changes to EndPoint Monitor. "+
+			"Unset read_only, or EPM_READ_ONLY, to allow changes.",
+	)
+}
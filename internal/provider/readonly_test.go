@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccReadOnly(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(readOnly bool, timeoutTime int, extra string) string {
+		return fmt.Sprintf(`
+provider "endpointmonitor" {
+  url       = %q
+  key       = %q
+  read_only = %t
+}
+`, server.APIURL(), testAccKey, readOnly) + testAccCheckDependencies + fmt.Sprintf(`
+resource "endpointmonitor_ping_check" "test" {
+  name                  = "Test Ping Check"
+  description           = "Test ping check."
+  check_frequency       = 30
+  hostname              = "www.mycompany.com"
+  warning_response_time = 2000
+  timeout_time          = %d
+  trigger_count         = 3
+  check_host_id         = endpointmonitor_check_host.test.id
+  check_group_id        = endpointmonitor_check_group.test.id
+}
+%s`, timeoutTime, extra)
+	}
+
+	// writes counts the requests made to the fake that would change it.
+	writes := func() int {
+		n := 0
+		for _, request := range server.Requests() {
+			if !strings.HasPrefix(request, http.MethodGet+" ") {
+				n++
+			}
+		}
+		return n
+	}
+
+	var writesBefore int
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				Config: config(false, 5000, ""),
+			},
+			{
+				// Reading what exists is still allowed, and finds nothing to change.
+				PreConfig: func() { writesBefore = writes() },
+				Config:    config(true, 5000, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Refreshing uses the configuration of the step before, so is read only.
+				RefreshState: true,
+			},
+			{
+				Config:      config(true, 8000, ""),
+				ExpectError: regexp.MustCompile(`(?s)Change Not Allowed in Read-Only Mode.*This item would be changed`),
+			},
+			{
+				Config: config(true, 5000, `
+resource "endpointmonitor_dashboard_group" "new" {
+  name        = "New Dashboard Group"
+  description = "New dashboard group."
+}
+`),
+				ExpectError: regexp.MustCompile(`(?s)Change Not Allowed in Read-Only Mode.*This item would be created`),
+			},
+			{
+				// Nothing was sent that would have changed EPM.
+				PreConfig: func() {
+					if n := writes(); n != writesBefore {
+						t.Errorf("made %d requests that would change EPM while read only", n-writesBefore)
+					}
+				},
+				Config: config(false, 5000, ""),
+			},
+		},
+	})
+}
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
@@ -24,9 +26,16 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &AndroidJourneyCheckResource{}
+	_ resource.Resource               = &AndroidJourneyCheckResource{}
+	_ resource.ResourceWithModifyPlan = &AndroidJourneyCheckResource{}
 )
 
+// Android Journey steps are sent to the API as a single list, but split between the
+// common_step and custom_step blocks, so errors against them can't be mapped by index.
+var androidJourneyFieldNames = map[string]string{
+	"steps": "",
+}
+
 func NewAndroidJourneyCheckResource() resource.Resource {
 	return &AndroidJourneyCheckResource{}
 }
@@ -41,7 +50,7 @@ func (r *AndroidJourneyCheckResource) Metadata(_ context.Context, req resource.M
 }
 
 // Schema defines the schema for the resource.
-func (r *AndroidJourneyCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AndroidJourneyCheckResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A check that can navigate a given Android App and check interactions function successfully and element are displayed as expected.",
 		Attributes: map[string]schema.Attribute{
@@ -82,8 +91,9 @@ func (r *AndroidJourneyCheckResource) Schema(_ context.Context, _ resource.Schem
 				Description: "If set true then notifications and alerts will be suppressed for the check.",
 			},
 			"trigger_count": schema.Int32Attribute{
-				Required:    true,
-				Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification. Defaults to trigger_count in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -91,8 +101,7 @@ func (r *AndroidJourneyCheckResource) Schema(_ context.Context, _ resource.Schem
 			"result_retention": schema.Int32Attribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     int32default.StaticInt32(366),
-				Description: "The number of days to store historic results of the check.",
+				Description: "The number of days to store historic results of the check. Defaults to result_retention in the defaults block of the provider, or 366 if that isn't set.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -106,21 +115,24 @@ func (r *AndroidJourneyCheckResource) Schema(_ context.Context, _ resource.Schem
 			},
 			"check_host_group_id": schema.Int32Attribute{
 				Optional:    true,
-				Description: "The id of the Check Host Group to run the check on. This group must contain at least one Android Check Host to work.",
+				Computed:    true,
+				Description: "The id of the Check Host Group to run the check on. This group must contain at least one Android Check Host to work. Defaults to check_host_group_id in the defaults block of the provider when check_host_id isn't set.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"check_group_id": schema.Int32Attribute{
-				Required:    true,
-				Description: "The id of the Check Group the check belongs to. This also determines check frequency.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The id of the Check Group the check belongs to. This also determines check frequency. Defaults to check_group_id in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"proxy_host_id": schema.Int32Attribute{
 				Optional:    true,
-				Description: "The id of the Proxy Host the check should use for a HTTP proxy if needed.",
+				Computed:    true,
+				Description: "The id of the Proxy Host the check should use for a HTTP proxy if needed. Defaults to proxy_host_id in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -158,6 +170,7 @@ func (r *AndroidJourneyCheckResource) Schema(_ context.Context, _ resource.Schem
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 			"common_step": schema.ListNestedBlock{
 				Description: "Adds a common shared step to a given Android Journey check.",
 				NestedObject: schema.NestedBlockObject{
@@ -603,12 +616,18 @@ func (r *AndroidJourneyCheckResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultApkUploadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	check, error := r.client.CreateAndroidJourneyCheck(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating check", "Could not create check", error, androidJourneyFieldNames)
 		return
 	}
 
@@ -630,6 +649,7 @@ func (r *AndroidJourneyCheckResource) Create(ctx context.Context, req resource.C
 	}
 
 	check.Apk = plan.Apk
+	check.Timeouts = plan.Timeouts
 	plan = *check
 
 	// Set state to fully populated data
@@ -650,8 +670,22 @@ func (r *AndroidJourneyCheckResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed check from EPM
-	check, err := r.client.GetAndroidJourneyCheck(state.Id.ValueInt64())
+	check, err := r.client.GetAndroidJourneyCheck(state.Id.ValueInt64(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Check",
@@ -660,11 +694,6 @@ func (r *AndroidJourneyCheckResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	if check == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
 	// Because the response we get from the EPM API doesn't contain the any password_input values,
 	// to be able to just copy its response to the state, we need to grab the passwords from the
@@ -697,6 +726,7 @@ func (r *AndroidJourneyCheckResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	check.Apk = state.Apk
+	check.Timeouts = state.Timeouts
 	state = *check
 
 	// Set refreshed state
@@ -716,12 +746,18 @@ func (r *AndroidJourneyCheckResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultApkUploadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	check, error := r.client.UpdateAndroidJourneyCheck(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating check", "Could not update check", error, androidJourneyFieldNames)
 		return
 	}
 
@@ -743,6 +779,7 @@ func (r *AndroidJourneyCheckResource) Update(ctx context.Context, req resource.U
 	}
 
 	check.Apk = plan.Apk
+	check.Timeouts = plan.Timeouts
 	plan = *check
 
 	// Set state to fully populated data
@@ -756,10 +793,24 @@ func (r *AndroidJourneyCheckResource) Update(ctx context.Context, req resource.U
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *AndroidJourneyCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan AndroidJourneyCheckModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteCheck(plan.Id.ValueInt64())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCheck(plan.Id.ValueInt64(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing check",
 			"Request to EPM to delete check returned an error: "+err.Error(),
@@ -768,6 +819,36 @@ func (r *AndroidJourneyCheckResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
+// ModifyPlan adjusts and checks the planned changes to the resource.
+func (r *AndroidJourneyCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	defer r.client.checkReadOnly(req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.applyCheckDefaults(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan AndroidJourneyCheckModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	uses := []featureUse{}
+	for i, step := range plan.CustomSteps {
+		uses = append(uses, androidJourneyStepFeatures(path.Root("custom_step").AtListIndex(i), step.StepChecks, step.StepInteractions)...)
+	}
+
+	r.client.checkFeatures(ctx, uses, &resp.Diagnostics)
+}
+
 func (r *AndroidJourneyCheckResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccAndroidJourneyCheckResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, apk string) string {
+		return testAccConfig(server, testAccCheckDependencies+`
+resource "endpointmonitor_check_host" "android" {
+  hostname    = "test-android-01.mycompany.com"
+  description = "Test Android host."
+  type        = "ANDROID"
+  enabled     = true
+}
+
+resource "endpointmonitor_check_host_group" "test" {
+  name           = "Test Host Group"
+  description    = "Test host group."
+  enabled        = true
+  check_host_ids = [endpointmonitor_check_host.android.id]
+}
+
+resource "endpointmonitor_android_journey_common_step" "test" {
+  name        = "Test Android Journey Common Step"
+  description = "Test Android journey common step."
+  wait_time   = 5000
+}
+
+resource "endpointmonitor_android_journey_check" "test" {
+  name            = "Test Android Journey Check"
+  description     = "Test Android journey check, revision %d."
+  enabled         = true
+  check_frequency = 120
+  trigger_count   = 2
+
+  # The fake doesn't inspect the APK, so any content will do.
+  apk = base64encode(%q)
+
+  common_step {
+    sequence       = 1
+    common_step_id = endpointmonitor_android_journey_common_step.test.id
+  }
+
+  custom_step {
+    sequence  = 2
+    name      = "Login"
+    wait_time = 5000
+
+    step_check {
+      description = "Check not already logged in."
+      type        = "CHECK_FOR_TEXT"
+
+      check_for_text {
+        text_to_find = "Logout"
+        state        = "ABSENT"
+      }
+    }
+
+    step_interaction {
+      sequence        = 1
+      description     = "Enter username"
+      always_required = true
+      type            = "INPUT_TEXT"
+
+      text_input {
+        component_id = "login_username"
+        input_text   = "my.user@mycompany.com"
+      }
+    }
+  }
+
+  check_host_group_id = endpointmonitor_check_host_group.test.id
+  check_group_id      = endpointmonitor_check_group.test.id
+  proxy_host_id       = endpointmonitor_proxy_host.test.id
+}
+`, revision, apk)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, "fake apk"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_android_journey_check.test", "common_step.#", "1"),
+					resource.TestCheckResourceAttrPair("endpointmonitor_android_journey_check.test", "common_step.0.common_step_id", "endpointmonitor_android_journey_common_step.test", "id"),
+					resource.TestCheckResourceAttr("endpointmonitor_android_journey_check.test", "custom_step.0.step_interaction.0.text_input.component_id", "login_username"),
+					resource.TestCheckResourceAttrPair("endpointmonitor_android_journey_check.test", "check_host_group_id", "endpointmonitor_check_host_group.test", "id"),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_android_journey_check.test", map[string]any{
+						"checkType": "ANDROID_JOURNEY",
+						"apk":       "ZmFrZSBhcGs=",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_android_journey_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// EPM never returns the APK, only its checksum, so it can't be imported.
+				ImportStateVerifyIgnore: []string{"apk"},
+			},
+			{
+				Config: config(2, "updated fake apk"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_android_journey_check.test", "description", "Test Android journey check, revision 2."),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_android_journey_check.test", map[string]any{
+						"apk": "dXBkYXRlZCBmYWtlIGFwaw==",
+					}),
+				),
+			},
+		},
+	})
+}
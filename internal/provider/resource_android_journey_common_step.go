@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -23,7 +25,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &AndroidJourneyCommonStepResource{}
+	_ resource.Resource               = &AndroidJourneyCommonStepResource{}
+	_ resource.ResourceWithModifyPlan = &AndroidJourneyCommonStepResource{}
 )
 
 func NewAndroidJourneyCommonStepResource() resource.Resource {
@@ -40,7 +43,7 @@ func (r *AndroidJourneyCommonStepResource) Metadata(_ context.Context, req resou
 }
 
 // Schema defines the schema for the resource.
-func (r *AndroidJourneyCommonStepResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AndroidJourneyCommonStepResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Defines a shared complete step of an Android Journey, starting with the checks to perform on what is currently displayed, followed by the actions to take.",
 		Attributes: map[string]schema.Attribute{
@@ -75,6 +78,7 @@ func (r *AndroidJourneyCommonStepResource) Schema(_ context.Context, _ resource.
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 			"step_check": schema.ListNestedBlock{
 				Description: "Defines the checks performed as part of an Android Journey Step to validate the currently displayed content of an app.",
 				NestedObject: schema.NestedBlockObject{
@@ -455,12 +459,18 @@ func (r *AndroidJourneyCommonStepResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	step, error := r.client.CreateAndroidJourneyCommonStep(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Common Step",
-			"Could not create Common Step, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating Common Step", "Could not create Common Step", error)
 		return
 	}
 
@@ -477,6 +487,7 @@ func (r *AndroidJourneyCommonStepResource) Create(ctx context.Context, req resou
 		}
 	}
 
+	step.Timeouts = plan.Timeouts
 	plan = *step
 
 	// Set state to fully populated data
@@ -497,8 +508,22 @@ func (r *AndroidJourneyCommonStepResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed check from EPM
-	commonStep, err := r.client.GetCommonAndroidJourneyStep(state.Id.ValueInt64())
+	commonStep, err := r.client.GetCommonAndroidJourneyStep(state.Id.ValueInt64(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching Common Step",
@@ -507,11 +532,6 @@ func (r *AndroidJourneyCommonStepResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	if commonStep == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
 	// Because the response we get from the EPM API doesn't contain the any password_input values,
 	// to be able to just copy its response to the state, we need to grab the passwords from the
@@ -539,6 +559,7 @@ func (r *AndroidJourneyCommonStepResource) Read(ctx context.Context, req resourc
 		}
 	}
 
+	commonStep.Timeouts = state.Timeouts
 	state = *commonStep
 
 	// Set refreshed state
@@ -559,12 +580,18 @@ func (r *AndroidJourneyCommonStepResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	step, error := r.client.UpdateAndroidJourneyCommonStep(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Common Step",
-			"Could not create Common Step, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating Common Step", "Could not update Common Step", error)
 		return
 	}
 
@@ -581,6 +608,7 @@ func (r *AndroidJourneyCommonStepResource) Update(ctx context.Context, req resou
 		}
 	}
 
+	step.Timeouts = plan.Timeouts
 	plan = *step
 
 	// Set state to fully populated data
@@ -595,10 +623,24 @@ func (r *AndroidJourneyCommonStepResource) Update(ctx context.Context, req resou
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *AndroidJourneyCommonStepResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan AndroidJourneyCommonStepModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteAndroidCommonStep(plan.Id.ValueInt64())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteAndroidCommonStep(plan.Id.ValueInt64(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing Common Step",
 			"Request to EPM to delete Common Step returned an error: "+err.Error(),
@@ -607,6 +649,28 @@ func (r *AndroidJourneyCommonStepResource) Delete(ctx context.Context, req resou
 	}
 }
 
+// ModifyPlan checks the planned changes to the resource.
+func (r *AndroidJourneyCommonStepResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	defer r.client.checkReadOnly(req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AndroidJourneyCommonStepModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	uses := androidJourneyStepFeatures(path.Empty(), plan.StepChecks, plan.StepInteractions)
+
+	r.client.checkFeatures(ctx, uses, &resp.Diagnostics)
+}
+
 func (r *AndroidJourneyCommonStepResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccAndroidJourneyCommonStepResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, waitTime int) string {
+		return testAccConfig(server, `
+resource "endpointmonitor_android_journey_common_step" "test" {
+  name        = "Test Android Journey Common Step"
+  description = "Test Android journey common step, revision %d."
+  wait_time   = %d
+
+  step_check {
+    description = "Check terms are shown."
+    type        = "CHECK_FOR_TEXT"
+
+    check_for_text {
+      text_to_find = "Terms and Conditions"
+      state        = "PRESENT"
+    }
+  }
+
+  step_interaction {
+    sequence        = 1
+    description     = "Accept terms"
+    always_required = true
+    type            = "CLICK"
+
+    click {
+      search_text = "Accept"
+    }
+  }
+}
+`, revision, waitTime)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.AndroidJourneyCommonSteps),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, 5000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_android_journey_common_step.test", "wait_time", "5000"),
+					resource.TestCheckResourceAttr("endpointmonitor_android_journey_common_step.test", "step_check.0.check_for_text.text_to_find", "Terms and Conditions"),
+					resource.TestCheckResourceAttr("endpointmonitor_android_journey_common_step.test", "step_interaction.0.click.search_text", "Accept"),
+					testAccCheckStored(server, fakeepm.AndroidJourneyCommonSteps, "endpointmonitor_android_journey_common_step.test", map[string]any{
+						"name":     "Test Android Journey Common Step",
+						"waitTime": 5000,
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_android_journey_common_step.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, 8000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_android_journey_common_step.test", "description", "Test Android journey common step, revision 2."),
+					testAccCheckStored(server, fakeepm.AndroidJourneyCommonSteps, "endpointmonitor_android_journey_common_step.test", map[string]any{
+						"waitTime": 8000,
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,16 +2,17 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,7 +20,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &CertificateCheckResource{}
+	_ resource.Resource               = &CertificateCheckResource{}
+	_ resource.ResourceWithModifyPlan = &CertificateCheckResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -38,7 +40,7 @@ func (r *CertificateCheckResource) Metadata(_ context.Context, req resource.Meta
 }
 
 // Schema defines the schema for the resource.
-func (r *CertificateCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *CertificateCheckResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Create and manage TLS certificate checks that test a given URL for an expected response.",
 		Attributes: map[string]schema.Attribute{
@@ -79,8 +81,9 @@ func (r *CertificateCheckResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "If set true then notifications and alerts will be suppressed for the check.",
 			},
 			"trigger_count": schema.Int32Attribute{
-				Required:    true,
-				Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification. Defaults to trigger_count in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -88,8 +91,7 @@ func (r *CertificateCheckResource) Schema(_ context.Context, _ resource.SchemaRe
 			"result_retention": schema.Int32Attribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     int32default.StaticInt32(366),
-				Description: "The number of days to store historic results of the check.",
+				Description: "The number of days to store historic results of the check. Defaults to result_retention in the defaults block of the provider, or 366 if that isn't set.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -103,21 +105,24 @@ func (r *CertificateCheckResource) Schema(_ context.Context, _ resource.SchemaRe
 			},
 			"check_host_group_id": schema.Int32Attribute{
 				Optional:    true,
-				Description: "The id of the Check Host Group to run the check on.",
+				Computed:    true,
+				Description: "The id of the Check Host Group to run the check on. Defaults to check_host_group_id in the defaults block of the provider when check_host_id isn't set.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"check_group_id": schema.Int32Attribute{
-				Required:    true,
-				Description: "The id of the Check Group the check belongs to. This also determines check frequency.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The id of the Check Group the check belongs to. This also determines check frequency. Defaults to check_group_id in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"proxy_host_id": schema.Int32Attribute{
 				Optional:    true,
-				Description: "The id of the Proxy Host the check should use for a HTTP proxy if needed.",
+				Computed:    true,
+				Description: "The id of the Proxy Host the check should use for a HTTP proxy if needed. Defaults to proxy_host_id in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -156,6 +161,9 @@ func (r *CertificateCheckResource) Schema(_ context.Context, _ resource.SchemaRe
 				Default:     booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -168,12 +176,18 @@ func (r *CertificateCheckResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	check, error := r.client.CreateCertificateCheck(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating check", "Could not create check", error)
 		return
 	}
 
@@ -198,8 +212,22 @@ func (r *CertificateCheckResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed check from EPM
-	check, err := r.client.GetCertificateCheck(state.Id.ValueInt64())
+	check, err := r.client.GetCertificateCheck(state.Id.ValueInt64(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Check",
@@ -208,12 +236,8 @@ func (r *CertificateCheckResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	if check == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
+	check.Timeouts = state.Timeouts
 	state = *check
 
 	// Set refreshed state
@@ -233,12 +257,18 @@ func (r *CertificateCheckResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	check, error := r.client.UpdateCertificateCheck(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating check", "Could not update check", error)
 		return
 	}
 
@@ -256,10 +286,24 @@ func (r *CertificateCheckResource) Update(ctx context.Context, req resource.Upda
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *CertificateCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan CertificateCheckModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteCheck(plan.Id.ValueInt64())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCheck(plan.Id.ValueInt64(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing check",
 			"Request to EPM to delete check returned an error: "+err.Error(),
@@ -268,6 +312,21 @@ func (r *CertificateCheckResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+// ModifyPlan adjusts and checks the planned changes to the resource.
+func (r *CertificateCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	defer r.client.checkReadOnly(req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.applyCheckDefaults(ctx, req, resp)
+}
+
 func (r *CertificateCheckResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
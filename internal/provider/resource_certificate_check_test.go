@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccCertificateCheckResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, warningDaysRemaining int) string {
+		return testAccConfig(server, testAccCheckDependencies+`
+resource "endpointmonitor_certificate_check" "test" {
+  name                   = "Test Certificate Check"
+  description            = "Test certificate check, revision %d."
+  check_frequency        = 3600
+  url                    = "https://www.mycompany.com/"
+  warning_days_remaining = %d
+  alert_days_remaining   = 2
+  trigger_count          = 2
+  check_host_id          = endpointmonitor_check_host.test.id
+  check_group_id         = endpointmonitor_check_group.test.id
+}
+`, revision, warningDaysRemaining)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_certificate_check.test", "url", "https://www.mycompany.com/"),
+					resource.TestCheckResourceAttr("endpointmonitor_certificate_check.test", "warning_days_remaining", "7"),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_certificate_check.test", map[string]any{
+						"checkType":            "TLS_CERTIFICATE",
+						"url":                  "https://www.mycompany.com/",
+						"warningDaysRemaining": 7,
+						"alertDaysRemaining":   2,
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_certificate_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_certificate_check.test", "description", "Test certificate check, revision 2."),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_certificate_check.test", map[string]any{
+						"warningDaysRemaining": 14,
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,7 +19,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &CheckGroupResource{}
+	_ resource.Resource               = &CheckGroupResource{}
+	_ resource.ResourceWithModifyPlan = &CheckGroupResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -36,7 +39,7 @@ func (r *CheckGroupResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *CheckGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *CheckGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Create and manage Check Groups, the initial grouping of checks running on EndPoint Monitor.",
 		Attributes: map[string]schema.Attribute{
@@ -68,6 +71,9 @@ func (r *CheckGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -80,12 +86,18 @@ func (r *CheckGroupResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	checkGroup, error := r.client.CreateCheckGroup(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check group",
-			"Could not create check group, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating check group", "Could not create check group", error)
 		return
 	}
 
@@ -110,8 +122,22 @@ func (r *CheckGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed check from EPM
-	checkGroup, err := r.client.GetCheckGroup(state.Id.ValueInt32())
+	checkGroup, err := r.client.GetCheckGroup(state.Id.ValueInt32(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Check Group",
@@ -120,12 +146,8 @@ func (r *CheckGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	if checkGroup == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
+	checkGroup.Timeouts = state.Timeouts
 	state = *checkGroup
 
 	// Set refreshed state
@@ -145,12 +167,18 @@ func (r *CheckGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	checkGroup, error := r.client.UpdateCheckGroup(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check group",
-			"Could not create check group, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating check group", "Could not update check group", error)
 		return
 	}
 
@@ -168,10 +196,24 @@ func (r *CheckGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *CheckGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan CheckGroupModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteCheckGroup(plan.Id.ValueInt32())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCheckGroup(plan.Id.ValueInt32(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing check group",
 			"Request to EPM to delete check group returned an error: "+err.Error(),
@@ -180,6 +222,15 @@ func (r *CheckGroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// ModifyPlan checks the planned changes to the resource.
+func (r *CheckGroupResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	r.client.checkReadOnly(req, resp)
+}
+
 func (r *CheckGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
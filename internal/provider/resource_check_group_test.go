@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccCheckGroupResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int) string {
+		return testAccConfig(server, `
+resource "endpointmonitor_dashboard_group" "test" {
+  name        = "Test Dashboard Group"
+  description = "Test dashboard group."
+}
+
+resource "endpointmonitor_check_group" "test" {
+  name               = "Test Check Group"
+  description        = "Test check group, revision %d."
+  dashboard_group_id = endpointmonitor_dashboard_group.test.id
+}
+`, revision)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.CheckGroups),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_check_group.test", "name", "Test Check Group"),
+					resource.TestCheckResourceAttr("endpointmonitor_check_group.test", "description", "Test check group, revision 1."),
+					resource.TestCheckResourceAttrPair("endpointmonitor_check_group.test", "dashboard_group_id", "endpointmonitor_dashboard_group.test", "id"),
+					testAccCheckStored(server, fakeepm.CheckGroups, "endpointmonitor_check_group.test", map[string]any{
+						"name":        "Test Check Group",
+						"description": "Test check group, revision 1.",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_check_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_check_group.test", "description", "Test check group, revision 2."),
+					testAccCheckStored(server, fakeepm.CheckGroups, "endpointmonitor_check_group.test", map[string]any{
+						"description": "Test check group, revision 2.",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,7 +21,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &CheckHostResource{}
+	_ resource.Resource               = &CheckHostResource{}
+	_ resource.ResourceWithModifyPlan = &CheckHostResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -38,7 +41,7 @@ func (r *CheckHostResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 // Schema defines the schema for the resource.
-func (r *CheckHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *CheckHostResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Create and manage the hosts that checks are to be run on.",
 		Attributes: map[string]schema.Attribute{
@@ -91,6 +94,9 @@ func (r *CheckHostResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Default:     booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -103,12 +109,18 @@ func (r *CheckHostResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	checkHost, error := r.client.CreateCheckHost(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check host",
-			"Could not create check host, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating check host", "Could not create check host", error)
 		return
 	}
 
@@ -133,8 +145,22 @@ func (r *CheckHostResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed check from EPM
-	checkHost, err := r.client.GetCheckHost(state.Id.ValueInt32())
+	checkHost, err := r.client.GetCheckHost(state.Id.ValueInt32(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Check Host",
@@ -143,12 +169,8 @@ func (r *CheckHostResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	if checkHost == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
+	checkHost.Timeouts = state.Timeouts
 	state = *checkHost
 
 	// Set refreshed state
@@ -168,12 +190,18 @@ func (r *CheckHostResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	checkHost, error := r.client.UpdateCheckHost(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check host",
-			"Could not create check host, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating check host", "Could not update check host", error)
 		return
 	}
 
@@ -191,10 +219,24 @@ func (r *CheckHostResource) Update(ctx context.Context, req resource.UpdateReque
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *CheckHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan CheckHostModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteCheckHost(plan.Id.ValueInt32())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCheckHost(plan.Id.ValueInt32(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing check host",
 			"Request to EPM to delete check host returned an error: "+err.Error(),
@@ -203,6 +245,15 @@ func (r *CheckHostResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// ModifyPlan checks the planned changes to the resource.
+func (r *CheckHostResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	r.client.checkReadOnly(req, resp)
+}
+
 func (r *CheckHostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
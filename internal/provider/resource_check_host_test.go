@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccCheckHostResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, maxChecks int) string {
+		return testAccConfig(server, `
+resource "endpointmonitor_check_host" "test" {
+  hostname         = "test-agent-01.mycompany.com"
+  description      = "Test agent, revision %d."
+  type             = "AGENT"
+  enabled          = true
+  max_checks       = %d
+  send_check_files = true
+}
+`, revision, maxChecks)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.CheckHosts),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_check_host.test", "hostname", "test-agent-01.mycompany.com"),
+					resource.TestCheckResourceAttr("endpointmonitor_check_host.test", "type", "AGENT"),
+					resource.TestCheckResourceAttr("endpointmonitor_check_host.test", "max_checks", "2"),
+					resource.TestCheckResourceAttr("endpointmonitor_check_host.test", "send_check_files", "true"),
+					testAccCheckStored(server, fakeepm.CheckHosts, "endpointmonitor_check_host.test", map[string]any{
+						"hostname":    "test-agent-01.mycompany.com",
+						"description": "Test agent, revision 1.",
+						"type":        "AGENT",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_check_host.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_check_host.test", "description", "Test agent, revision 2."),
+					resource.TestCheckResourceAttr("endpointmonitor_check_host.test", "max_checks", "4"),
+					testAccCheckStored(server, fakeepm.CheckHosts, "endpointmonitor_check_host.test", map[string]any{
+						"description": "Test agent, revision 2.",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,7 +18,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &DashboardGroupResource{}
+	_ resource.Resource               = &DashboardGroupResource{}
+	_ resource.ResourceWithModifyPlan = &DashboardGroupResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -35,7 +38,7 @@ func (r *DashboardGroupResource) Metadata(_ context.Context, req resource.Metada
 }
 
 // Schema defines the schema for the resource.
-func (r *DashboardGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DashboardGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Create and manage Dashboard Groups, the top-level organisational groups of checks running in EndPoint Monitor.",
 		Attributes: map[string]schema.Attribute{
@@ -60,6 +63,9 @@ func (r *DashboardGroupResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -72,12 +78,18 @@ func (r *DashboardGroupResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	dashboardGroup, error := r.client.CreateDashboardGroup(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating dashboard group",
-			"Could not create dashboard group, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating dashboard group", "Could not create dashboard group", error)
 		return
 	}
 
@@ -102,8 +114,22 @@ func (r *DashboardGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed data from EPM
-	dashboardGroup, err := r.client.GetDashboardGroup(state.Id.ValueInt32())
+	dashboardGroup, err := r.client.GetDashboardGroup(state.Id.ValueInt32(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Dashboard Group",
@@ -112,12 +138,8 @@ func (r *DashboardGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	if dashboardGroup == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
+	dashboardGroup.Timeouts = state.Timeouts
 	state = *dashboardGroup
 
 	// Set refreshed state
@@ -137,12 +159,18 @@ func (r *DashboardGroupResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	dashboardGroup, error := r.client.UpdateDashboardGroup(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating dashboard group",
-			"Could not create dashboard group, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating dashboard group", "Could not update dashboard group", error)
 		return
 	}
 
@@ -160,10 +188,24 @@ func (r *DashboardGroupResource) Update(ctx context.Context, req resource.Update
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *DashboardGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan DashboardGroupModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteDashboardGroup(plan.Id.ValueInt32())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteDashboardGroup(plan.Id.ValueInt32(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing dashboard group",
 			"Request to EPM to delete dashboard group returned an error: "+err.Error(),
@@ -172,6 +214,15 @@ func (r *DashboardGroupResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+// ModifyPlan checks the planned changes to the resource.
+func (r *DashboardGroupResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	r.client.checkReadOnly(req, resp)
+}
+
 func (r *DashboardGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccDashboardGroupResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int) string {
+		return testAccConfig(server, `
+resource "endpointmonitor_dashboard_group" "test" {
+  name        = "Test Dashboard Group"
+  description = "Test dashboard group, revision %d."
+}
+`, revision)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.DashboardGroups),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_dashboard_group.test", "id", "1"),
+					resource.TestCheckResourceAttr("endpointmonitor_dashboard_group.test", "name", "Test Dashboard Group"),
+					resource.TestCheckResourceAttr("endpointmonitor_dashboard_group.test", "description", "Test dashboard group, revision 1."),
+					testAccCheckStored(server, fakeepm.DashboardGroups, "endpointmonitor_dashboard_group.test", map[string]any{
+						"name":        "Test Dashboard Group",
+						"description": "Test dashboard group, revision 1.",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_dashboard_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_dashboard_group.test", "description", "Test dashboard group, revision 2."),
+					testAccCheckStored(server, fakeepm.DashboardGroups, "endpointmonitor_dashboard_group.test", map[string]any{
+						"description": "Test dashboard group, revision 2.",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -12,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,7 +22,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &DnsCheckResource{}
+	_ resource.Resource               = &DnsCheckResource{}
+	_ resource.ResourceWithModifyPlan = &DnsCheckResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -40,7 +42,7 @@ func (r *DnsCheckResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *DnsCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DnsCheckResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Create and manage DNS checks which check that a hostname revolves to a known set of addresses.",
 		Attributes: map[string]schema.Attribute{
@@ -81,8 +83,9 @@ func (r *DnsCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "If set true then notifications and alerts will be suppressed for the check.",
 			},
 			"trigger_count": schema.Int32Attribute{
-				Required:    true,
-				Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification. Defaults to trigger_count in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -90,8 +93,7 @@ func (r *DnsCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"result_retention": schema.Int32Attribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     int32default.StaticInt32(366),
-				Description: "The number of days to store historic results of the check.",
+				Description: "The number of days to store historic results of the check. Defaults to result_retention in the defaults block of the provider, or 366 if that isn't set.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -105,21 +107,24 @@ func (r *DnsCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"check_host_group_id": schema.Int32Attribute{
 				Optional:    true,
-				Description: "The id of the Check Host Group to run the check on.",
+				Computed:    true,
+				Description: "The id of the Check Host Group to run the check on. Defaults to check_host_group_id in the defaults block of the provider when check_host_id isn't set.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"check_group_id": schema.Int32Attribute{
-				Required:    true,
-				Description: "The id of the Check Group the check belongs to. This also determines check frequency.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The id of the Check Group the check belongs to. This also determines check frequency. Defaults to check_group_id in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"proxy_host_id": schema.Int32Attribute{
 				Optional:    true,
-				Description: "The id of the Proxy Host the check should use for a HTTP proxy if needed.",
+				Computed:    true,
+				Description: "The id of the Proxy Host the check should use for a HTTP proxy if needed. Defaults to proxy_host_id in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -140,6 +145,9 @@ func (r *DnsCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -152,12 +160,18 @@ func (r *DnsCheckResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	check, error := r.client.CreateDnsCheck(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating check", "Could not create check", error)
 		return
 	}
 
@@ -182,8 +196,22 @@ func (r *DnsCheckResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed check from EPM
-	check, err := r.client.GetDnsCheck(state.Id.ValueInt64())
+	check, err := r.client.GetDnsCheck(state.Id.ValueInt64(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Check",
@@ -192,12 +220,8 @@ func (r *DnsCheckResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	if check == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
+	check.Timeouts = state.Timeouts
 	state = *check
 
 	// Set refreshed state
@@ -217,12 +241,18 @@ func (r *DnsCheckResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	check, error := r.client.UpdateDnsCheck(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating check", "Could not update check", error)
 		return
 	}
 
@@ -240,10 +270,24 @@ func (r *DnsCheckResource) Update(ctx context.Context, req resource.UpdateReques
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *DnsCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan DnsCheckModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteCheck(plan.Id.ValueInt64())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCheck(plan.Id.ValueInt64(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing check",
 			"Request to EPM to delete check returned an error: "+err.Error(),
@@ -252,6 +296,21 @@ func (r *DnsCheckResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// ModifyPlan adjusts and checks the planned changes to the resource.
+func (r *DnsCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	defer r.client.checkReadOnly(req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.applyCheckDefaults(ctx, req, resp)
+}
+
 func (r *DnsCheckResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccDnsCheckResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, expectedAddresses string) string {
+		return testAccConfig(server, testAccCheckDependencies+`
+resource "endpointmonitor_dns_check" "test" {
+  name               = "Test DNS Check"
+  description        = "Test DNS check, revision %d."
+  check_frequency    = 300
+  hostname           = "one.one.one.one"
+  expected_addresses = [%s]
+  trigger_count      = 2
+  check_host_id      = endpointmonitor_check_host.test.id
+  check_group_id     = endpointmonitor_check_group.test.id
+}
+`, revision, expectedAddresses)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, `"1.1.1.1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_dns_check.test", "hostname", "one.one.one.one"),
+					resource.TestCheckResourceAttr("endpointmonitor_dns_check.test", "expected_addresses.#", "1"),
+					resource.TestCheckResourceAttrPair("endpointmonitor_dns_check.test", "check_group_id", "endpointmonitor_check_group.test", "id"),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_dns_check.test", map[string]any{
+						"checkType": "DNS",
+						"hostname":  "one.one.one.one",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_dns_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, `"1.0.0.1", "1.1.1.1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_dns_check.test", "description", "Test DNS check, revision 2."),
+					resource.TestCheckResourceAttr("endpointmonitor_dns_check.test", "expected_addresses.#", "2"),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_dns_check.test", map[string]any{
+						"expectedAddresses": []any{"1.0.0.1", "1.1.1.1"},
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,7 +20,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &HostGroupResource{}
+	_ resource.Resource               = &HostGroupResource{}
+	_ resource.ResourceWithModifyPlan = &HostGroupResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -37,7 +40,7 @@ func (r *HostGroupResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 // Schema defines the schema for the resource.
-func (r *HostGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *HostGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Create and manage groups of Check Hosts that can assigned to checks to execute on.",
 		Attributes: map[string]schema.Attribute{
@@ -73,6 +76,9 @@ func (r *HostGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType: types.Int32Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -85,12 +91,18 @@ func (r *HostGroupResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	hostGroup, error := r.client.CreateHostGroup(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating host group",
-			"Could not create host group, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating host group", "Could not create host group", error)
 		return
 	}
 
@@ -115,8 +127,22 @@ func (r *HostGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed data from EPM
-	hostGroup, err := r.client.GetHostGroup(state.Id.ValueInt32())
+	hostGroup, err := r.client.GetHostGroup(state.Id.ValueInt32(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Host Group",
@@ -125,12 +151,8 @@ func (r *HostGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	if hostGroup == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
+	hostGroup.Timeouts = state.Timeouts
 	state = *hostGroup
 
 	// Set refreshed state
@@ -150,12 +172,18 @@ func (r *HostGroupResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	hostGroup, error := r.client.UpdateHostGroup(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating host group",
-			"Could not create host group, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating host group", "Could not update host group", error)
 		return
 	}
 
@@ -173,10 +201,24 @@ func (r *HostGroupResource) Update(ctx context.Context, req resource.UpdateReque
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *HostGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan HostGroupModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteHostGroup(plan.Id.ValueInt32())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteHostGroup(plan.Id.ValueInt32(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing host group",
 			"Request to EPM to delete host group returned an error: "+err.Error(),
@@ -185,6 +227,15 @@ func (r *HostGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// ModifyPlan checks the planned changes to the resource.
+func (r *HostGroupResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	r.client.checkReadOnly(req, resp)
+}
+
 func (r *HostGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
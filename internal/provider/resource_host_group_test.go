@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccHostGroupResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, hosts string) string {
+		return testAccConfig(server, `
+resource "endpointmonitor_check_host" "first" {
+  hostname    = "test-android-01.mycompany.com"
+  description = "Test Android host."
+  type        = "ANDROID"
+  enabled     = true
+}
+
+resource "endpointmonitor_check_host" "second" {
+  hostname    = "test-android-02.mycompany.com"
+  description = "Test Android host."
+  type        = "ANDROID"
+  enabled     = true
+}
+
+resource "endpointmonitor_check_host_group" "test" {
+  name           = "Test Host Group"
+  description    = "Test host group, revision %d."
+  enabled        = true
+  check_host_ids = [%s]
+}
+`, revision, hosts)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.HostGroups),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, "endpointmonitor_check_host.first.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_check_host_group.test", "name", "Test Host Group"),
+					resource.TestCheckResourceAttr("endpointmonitor_check_host_group.test", "check_host_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("endpointmonitor_check_host_group.test", "check_host_ids.*", "endpointmonitor_check_host.first", "id"),
+					testAccCheckStored(server, fakeepm.HostGroups, "endpointmonitor_check_host_group.test", map[string]any{
+						"name":        "Test Host Group",
+						"description": "Test host group, revision 1.",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_check_host_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, "endpointmonitor_check_host.first.id, endpointmonitor_check_host.second.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_check_host_group.test", "description", "Test host group, revision 2."),
+					resource.TestCheckResourceAttr("endpointmonitor_check_host_group.test", "check_host_ids.#", "2"),
+					testAccCheckStored(server, fakeepm.HostGroups, "endpointmonitor_check_host_group.test", map[string]any{
+						"description": "Test host group, revision 2.",
+					}),
+				),
+			},
+		},
+	})
+}
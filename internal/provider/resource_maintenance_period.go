@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,7 +20,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &MaintenancePeriodResource{}
+	_ resource.Resource               = &MaintenancePeriodResource{}
+	_ resource.ResourceWithModifyPlan = &MaintenancePeriodResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -37,7 +40,7 @@ func (r *MaintenancePeriodResource) Metadata(_ context.Context, req resource.Met
 }
 
 // Schema defines the schema for the resource.
-func (r *MaintenancePeriodResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MaintenancePeriodResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Create and manage scheduled maintenance periods to prevent checks from alerting during certain periods of the day or week.",
 		Attributes: map[string]schema.Attribute{
@@ -95,6 +98,9 @@ func (r *MaintenancePeriodResource) Schema(_ context.Context, _ resource.SchemaR
 				ElementType: types.Int32Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -107,12 +113,18 @@ func (r *MaintenancePeriodResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	maintenancePeriod, error := r.client.CreateMaintenancePeriod(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating maintenance period",
-			"Could not create maintenance period, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating maintenance period", "Could not create maintenance period", error)
 		return
 	}
 
@@ -137,8 +149,22 @@ func (r *MaintenancePeriodResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed data from EPM
-	maintenancePeriod, err := r.client.GetMaintenancePeriod(state.Id.ValueInt32())
+	maintenancePeriod, err := r.client.GetMaintenancePeriod(state.Id.ValueInt32(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Maintenance Period",
@@ -147,12 +173,8 @@ func (r *MaintenancePeriodResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	if maintenancePeriod == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
+	maintenancePeriod.Timeouts = state.Timeouts
 	state = *maintenancePeriod
 
 	// Set refreshed state
@@ -172,12 +194,18 @@ func (r *MaintenancePeriodResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	maintenancePeriod, error := r.client.UpdateMaintenancePeriod(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating maintenance period",
-			"Could not create maintenance period, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating maintenance period", "Could not update maintenance period", error)
 		return
 	}
 
@@ -195,10 +223,24 @@ func (r *MaintenancePeriodResource) Update(ctx context.Context, req resource.Upd
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *MaintenancePeriodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan MaintenancePeriodModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteMaintenancePeriod(plan.Id.ValueInt32())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteMaintenancePeriod(plan.Id.ValueInt32(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing maintenance period",
 			"Request to EPM to delete maintenance period returned an error: "+err.Error(),
@@ -207,6 +249,15 @@ func (r *MaintenancePeriodResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+// ModifyPlan checks the planned changes to the resource.
+func (r *MaintenancePeriodResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	r.client.checkReadOnly(req, resp)
+}
+
 func (r *MaintenancePeriodResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccMaintenancePeriodResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, endTime string) string {
+		return testAccConfig(server, testAccCheckDependencies+`
+resource "endpointmonitor_ping_check" "test" {
+  name                  = "Test Ping Check"
+  description           = "Test ping check."
+  check_frequency       = 30
+  hostname              = "www.mycompany.com"
+  warning_response_time = 2000
+  timeout_time          = 5000
+  trigger_count         = 3
+  check_host_id         = endpointmonitor_check_host.test.id
+  check_group_id        = endpointmonitor_check_group.test.id
+}
+
+resource "endpointmonitor_maintenance_period" "test" {
+  description         = "Test maintenance period, revision %d."
+  enabled             = true
+  day_of_week         = "ALL"
+  start_time          = "01:00"
+  end_time            = %q
+  check_ids           = [endpointmonitor_ping_check.test.id]
+  check_group_ids     = [endpointmonitor_check_group.test.id]
+  dashboard_group_ids = [endpointmonitor_dashboard_group.test.id]
+}
+`, revision, endTime)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.MaintenancePeriods),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, "03:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_maintenance_period.test", "day_of_week", "ALL"),
+					resource.TestCheckResourceAttr("endpointmonitor_maintenance_period.test", "end_time", "03:00"),
+					resource.TestCheckTypeSetElemAttrPair("endpointmonitor_maintenance_period.test", "check_ids.*", "endpointmonitor_ping_check.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("endpointmonitor_maintenance_period.test", "check_group_ids.*", "endpointmonitor_check_group.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("endpointmonitor_maintenance_period.test", "dashboard_group_ids.*", "endpointmonitor_dashboard_group.test", "id"),
+					testAccCheckStored(server, fakeepm.MaintenancePeriods, "endpointmonitor_maintenance_period.test", map[string]any{
+						"description": "Test maintenance period, revision 1.",
+						"startTime":   "01:00",
+						"endTime":     "03:00",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_maintenance_period.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, "04:30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_maintenance_period.test", "description", "Test maintenance period, revision 2."),
+					testAccCheckStored(server, fakeepm.MaintenancePeriods, "endpointmonitor_maintenance_period.test", map[string]any{
+						"endTime": "04:30",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccPingCheckResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, timeoutTime int) string {
+		return testAccConfig(server, testAccCheckDependencies+`
+resource "endpointmonitor_ping_check" "test" {
+  name                  = "Test Ping Check"
+  description           = "Test ping check, revision %d."
+  check_frequency       = 30
+  hostname              = "www.mycompany.com"
+  warning_response_time = 2000
+  timeout_time          = %d
+  trigger_count         = 3
+  check_host_id         = endpointmonitor_check_host.test.id
+  check_group_id        = endpointmonitor_check_group.test.id
+}
+`, revision, timeoutTime)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, 5000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_ping_check.test", "hostname", "www.mycompany.com"),
+					resource.TestCheckResourceAttr("endpointmonitor_ping_check.test", "timeout_time", "5000"),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_ping_check.test", map[string]any{
+						"checkType":           "PING",
+						"hostname":            "www.mycompany.com",
+						"timeout":             5000,
+						"warningResponseTime": 2000,
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_ping_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, 8000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_ping_check.test", "description", "Test ping check, revision 2."),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_ping_check.test", map[string]any{
+						"timeout": 8000,
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,7 +19,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &ProxyHostResource{}
+	_ resource.Resource               = &ProxyHostResource{}
+	_ resource.ResourceWithModifyPlan = &ProxyHostResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -36,7 +39,7 @@ func (r *ProxyHostResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 // Schema defines the schema for the resource.
-func (r *ProxyHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ProxyHostResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Create and manage HTTP proxies that can be used for URL and Web Journey checks if a proxy is required to access the target.",
 		Attributes: map[string]schema.Attribute{
@@ -75,6 +78,9 @@ func (r *ProxyHostResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -87,12 +93,18 @@ func (r *ProxyHostResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	proxyHost, error := r.client.CreateProxyHost(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating proxy host",
-			"Could not create proxy host, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating proxy host", "Could not create proxy host", error)
 		return
 	}
 
@@ -117,8 +129,22 @@ func (r *ProxyHostResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed data from EPM
-	proxyHost, err := r.client.GetProxyHost(state.Id.ValueInt32())
+	proxyHost, err := r.client.GetProxyHost(state.Id.ValueInt32(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Proxy Host",
@@ -127,12 +153,8 @@ func (r *ProxyHostResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	if proxyHost == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
+	proxyHost.Timeouts = state.Timeouts
 	state = *proxyHost
 
 	// Set refreshed state
@@ -152,12 +174,18 @@ func (r *ProxyHostResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	proxyHost, error := r.client.UpdateProxyHost(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating proxy host",
-			"Could not create proxy host, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating proxy host", "Could not update proxy host", error)
 		return
 	}
 
@@ -175,10 +203,24 @@ func (r *ProxyHostResource) Update(ctx context.Context, req resource.UpdateReque
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *ProxyHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan ProxyHostModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteProxyHost(plan.Id.ValueInt32())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteProxyHost(plan.Id.ValueInt32(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing proxy host",
 			"Request to EPM to delete proxy host returned an error: "+err.Error(),
@@ -187,6 +229,15 @@ func (r *ProxyHostResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// ModifyPlan checks the planned changes to the resource.
+func (r *ProxyHostResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	r.client.checkReadOnly(req, resp)
+}
+
 func (r *ProxyHostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
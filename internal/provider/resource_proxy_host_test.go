@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccProxyHostResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, port int) string {
+		return testAccConfig(server, `
+resource "endpointmonitor_proxy_host" "test" {
+  name        = "Test Proxy Host"
+  description = "Test proxy host, revision %d."
+  hostname    = "test-proxy-01.mycompany.com"
+  port        = %d
+}
+`, revision, port)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.ProxyHosts),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, 3128),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_proxy_host.test", "name", "Test Proxy Host"),
+					resource.TestCheckResourceAttr("endpointmonitor_proxy_host.test", "hostname", "test-proxy-01.mycompany.com"),
+					resource.TestCheckResourceAttr("endpointmonitor_proxy_host.test", "port", "3128"),
+					testAccCheckStored(server, fakeepm.ProxyHosts, "endpointmonitor_proxy_host.test", map[string]any{
+						"name":     "Test Proxy Host",
+						"hostname": "test-proxy-01.mycompany.com",
+						"port":     3128,
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_proxy_host.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, 8080),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_proxy_host.test", "description", "Test proxy host, revision 2."),
+					resource.TestCheckResourceAttr("endpointmonitor_proxy_host.test", "port", "8080"),
+					testAccCheckStored(server, fakeepm.ProxyHosts, "endpointmonitor_proxy_host.test", map[string]any{
+						"port": 8080,
+					}),
+				),
+			},
+		},
+	})
+}
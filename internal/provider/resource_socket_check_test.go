@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccSocketCheckResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, port int) string {
+		return testAccConfig(server, testAccCheckDependencies+`
+resource "endpointmonitor_socket_check" "test" {
+  name            = "Test Socket Check"
+  description     = "Test socket check, revision %d."
+  check_frequency = 120
+  hostname        = "www.mycompany.com"
+  port            = %d
+  trigger_count   = 2
+  check_host_id   = endpointmonitor_check_host.test.id
+  check_group_id  = endpointmonitor_check_group.test.id
+}
+`, revision, port)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, 443),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_socket_check.test", "hostname", "www.mycompany.com"),
+					resource.TestCheckResourceAttr("endpointmonitor_socket_check.test", "port", "443"),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_socket_check.test", map[string]any{
+						"checkType": "SOCKET",
+						"hostname":  "www.mycompany.com",
+						"port":      443,
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_socket_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, 8443),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_socket_check.test", "description", "Test socket check, revision 2."),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_socket_check.test", map[string]any{
+						"port": 8443,
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccUrlCheckResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, expectedResponseCode int) string {
+		return testAccConfig(server, testAccCheckDependencies+`
+resource "endpointmonitor_url_check" "test" {
+  name                   = "Test URL Check"
+  description            = "Test URL check, revision %d."
+  check_frequency        = 60
+  url                    = "https://www.mycompany.com/"
+  request_method         = "GET"
+  expected_response_code = %d
+  timeout                = 10000
+  alert_response_time    = 5000
+  warning_response_time  = 3000
+  trigger_count          = 3
+
+  request_header {
+    name  = "Agent"
+    value = "EndPoint Monitor"
+  }
+
+  check_host_id  = endpointmonitor_check_host.test.id
+  check_group_id = endpointmonitor_check_group.test.id
+  proxy_host_id  = endpointmonitor_proxy_host.test.id
+}
+`, revision, expectedResponseCode)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_url_check.test", "url", "https://www.mycompany.com/"),
+					resource.TestCheckResourceAttr("endpointmonitor_url_check.test", "expected_response_code", "200"),
+					resource.TestCheckResourceAttr("endpointmonitor_url_check.test", "request_header.0.name", "Agent"),
+					resource.TestCheckResourceAttrPair("endpointmonitor_url_check.test", "check_host_id", "endpointmonitor_check_host.test", "id"),
+					resource.TestCheckResourceAttrPair("endpointmonitor_url_check.test", "proxy_host_id", "endpointmonitor_proxy_host.test", "id"),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_url_check.test", map[string]any{
+						"checkType":            "URL",
+						"url":                  "https://www.mycompany.com/",
+						"expectedResponseCode": 200,
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_url_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, 204),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_url_check.test", "description", "Test URL check, revision 2."),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_url_check.test", map[string]any{
+						"expectedResponseCode": 204,
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
@@ -25,7 +27,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &WebJourneyCheckResource{}
+	_ resource.Resource                 = &WebJourneyCheckResource{}
+	_ resource.ResourceWithModifyPlan   = &WebJourneyCheckResource{}
+	_ resource.ResourceWithUpgradeState = &WebJourneyCheckResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -44,8 +48,9 @@ func (r *WebJourneyCheckResource) Metadata(_ context.Context, req resource.Metad
 }
 
 // Schema defines the schema for the resource.
-func (r *WebJourneyCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *WebJourneyCheckResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Create and manage web journey checks that can be set up to navigate through a website and perform period checks to ensure page elements, network calls and console logs are there or not as expected.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -85,8 +90,9 @@ func (r *WebJourneyCheckResource) Schema(_ context.Context, _ resource.SchemaReq
 				Description: "If set true then notifications and alerts will be suppressed for the check.",
 			},
 			"trigger_count": schema.Int32Attribute{
-				Required:    true,
-				Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The sequential number of failures that need to occur for a check to trigger an alert or notification. Defaults to trigger_count in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -94,8 +100,7 @@ func (r *WebJourneyCheckResource) Schema(_ context.Context, _ resource.SchemaReq
 			"result_retention": schema.Int32Attribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     int32default.StaticInt32(366),
-				Description: "The number of days to store historic results of the check.",
+				Description: "The number of days to store historic results of the check. Defaults to result_retention in the defaults block of the provider, or 366 if that isn't set.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -109,21 +114,24 @@ func (r *WebJourneyCheckResource) Schema(_ context.Context, _ resource.SchemaReq
 			},
 			"check_host_group_id": schema.Int32Attribute{
 				Optional:    true,
-				Description: "The id of the Check Host Group to run the check on.",
+				Computed:    true,
+				Description: "The id of the Check Host Group to run the check on. Defaults to check_host_group_id in the defaults block of the provider when check_host_id isn't set.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"check_group_id": schema.Int32Attribute{
-				Required:    true,
-				Description: "The id of the Check Group the check belongs to. This also determines check frequency.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The id of the Check Group the check belongs to. This also determines check frequency. Defaults to check_group_id in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"proxy_host_id": schema.Int32Attribute{
 				Optional:    true,
-				Description: "The id of the Proxy Host the check should use for a HTTP proxy if needed.",
+				Computed:    true,
+				Description: "The id of the Proxy Host the check should use for a HTTP proxy if needed. Defaults to proxy_host_id in the defaults block of the provider.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
@@ -155,6 +163,7 @@ func (r *WebJourneyCheckResource) Schema(_ context.Context, _ resource.SchemaReq
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 			"monitor_domain": schema.ListNestedBlock{
 				Description: "Define a domain to monitor network calls from during the check. If no monitor_domain's are defined, then all calls will be monitored.",
 				NestedObject: schema.NestedBlockObject{
@@ -320,16 +329,16 @@ func (r *WebJourneyCheckResource) Schema(_ context.Context, _ resource.SchemaReq
 													int64planmodifier.UseStateForUnknown(),
 												},
 											},
-											"elemenet_id": schema.StringAttribute{
+											"element_id": schema.StringAttribute{
 												Optional:    true,
-												Description: "The id of the element to check.",
+												Description: "The id of the element to check. Formerly elemenet_id, which is renamed in existing state.",
 												Validators: []validator.String{
 													stringvalidator.LengthAtLeast(1),
 												},
 											},
-											"elemenet_name": schema.StringAttribute{
+											"element_name": schema.StringAttribute{
 												Optional:    true,
-												Description: "The name of the element to check.",
+												Description: "The name of the element to check. Formerly elemenet_name, which is renamed in existing state.",
 												Validators: []validator.String{
 													stringvalidator.LengthAtLeast(1),
 												},
@@ -896,12 +905,18 @@ func (r *WebJourneyCheckResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	check, error := r.client.CreateWebJourneyCheck(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating check", "Could not create check", error)
 		return
 	}
 
@@ -922,6 +937,7 @@ func (r *WebJourneyCheckResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	check.Timeouts = plan.Timeouts
 	plan = *check
 
 	// Set state to fully populated data
@@ -942,8 +958,22 @@ func (r *WebJourneyCheckResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed check from EPM
-	check, err := r.client.GetWebJourneyCheck(state.Id.ValueInt64())
+	check, err := r.client.GetWebJourneyCheck(state.Id.ValueInt64(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Check",
@@ -952,11 +982,6 @@ func (r *WebJourneyCheckResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	if check == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
 	// Because the response we get from the EPM API doesn't contain the any password_input values,
 	// to be able to just copy its response to the state, we need to grab the passwords from the
@@ -988,6 +1013,7 @@ func (r *WebJourneyCheckResource) Read(ctx context.Context, req resource.ReadReq
 		}
 	}
 
+	check.Timeouts = state.Timeouts
 	state = *check
 
 	// Set refreshed state
@@ -1007,12 +1033,18 @@ func (r *WebJourneyCheckResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	check, error := r.client.UpdateWebJourneyCheck(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating check", "Could not update check", error)
 		return
 	}
 
@@ -1033,6 +1065,7 @@ func (r *WebJourneyCheckResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
+	check.Timeouts = plan.Timeouts
 	plan = *check
 
 	// Set state to fully populated data
@@ -1046,10 +1079,24 @@ func (r *WebJourneyCheckResource) Update(ctx context.Context, req resource.Updat
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *WebJourneyCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan WebJourneyCheckModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteCheck(plan.Id.ValueInt64())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCheck(plan.Id.ValueInt64(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing check",
 			"Request to EPM to delete check returned an error: "+err.Error(),
@@ -1058,6 +1105,36 @@ func (r *WebJourneyCheckResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+// ModifyPlan adjusts and checks the planned changes to the resource.
+func (r *WebJourneyCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	defer r.client.checkReadOnly(req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.applyCheckDefaults(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan WebJourneyCheckModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	uses := []featureUse{}
+	for i, step := range plan.Steps {
+		uses = append(uses, webJourneyStepFeatures(path.Root("step").AtListIndex(i), step.PageChecks, step.Actions)...)
+	}
+
+	r.client.checkFeatures(ctx, uses, &resp.Diagnostics)
+}
+
 func (r *WebJourneyCheckResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
@@ -1083,3 +1160,11 @@ func (r *WebJourneyCheckResource) ImportState(ctx context.Context, req resource.
 	id, _ := strconv.Atoi(req.ID)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// UpgradeState renames the misspelt attributes of check_element_on_page in states from
+// before version 1 of the schema.
+func (r *WebJourneyCheckResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: renameStateAttributes(pageCheckElementRenames),
+	}
+}
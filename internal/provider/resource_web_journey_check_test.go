@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccWebJourneyCheckResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, startURL string) string {
+		return testAccConfig(server, testAccCheckDependencies+`
+resource "endpointmonitor_web_journey_common_step" "test" {
+  name                   = "Test Web Journey Common Step"
+  description            = "Test web journey common step."
+  wait_time              = 5000
+  page_load_time_warning = 2500
+  page_load_time_alert   = 5000
+}
+
+resource "endpointmonitor_web_journey_check" "test" {
+  name            = "Test Web Journey Check"
+  description     = "Test web journey check, revision %d."
+  check_frequency = 120
+  enabled         = true
+  start_url       = %q
+  trigger_count   = 3
+
+  monitor_domain {
+    domain              = "mycompany.com"
+    include_sub_domains = true
+  }
+
+  step {
+    sequence       = 0
+    name           = "Initial Page Load Checks"
+    type           = "COMMON"
+    common_step_id = endpointmonitor_web_journey_common_step.test.id
+  }
+
+  step {
+    sequence               = 1
+    name                   = "Login"
+    type                   = "CUSTOM"
+    wait_time              = 5000
+    page_load_time_warning = 2000
+    page_load_time_alert   = 5000
+
+    page_check {
+      description = "Check not already logged in."
+      type        = "CHECK_FOR_TEXT"
+
+      check_for_text {
+        text_to_find = "Logout"
+        state        = "ABSENT"
+      }
+    }
+
+    page_check {
+      description = "Check login form is shown."
+      type        = "CHECK_FOR_ELEMENT"
+
+      check_element_on_page {
+        element_id = "login_form"
+        state      = "PRESENT"
+      }
+    }
+
+    action {
+      sequence        = 1
+      description     = "Enter username"
+      always_required = true
+      type            = "TEXT_INPUT"
+
+      text_input {
+        element_id = "login_username"
+        input_text = "my.user@mycompany.com"
+      }
+    }
+
+    action {
+      sequence        = 2
+      description     = "Enter password"
+      always_required = true
+      type            = "PASSWORD_INPUT"
+
+      password_input {
+        element_id     = "login_password"
+        input_password = "KeepMeSecr3t"
+      }
+    }
+
+    action {
+      sequence        = 3
+      description     = "Choose region"
+      always_required = false
+      type            = "SELECT_OPTION"
+
+      select_option {
+        element_id   = "login_region"
+        option_value = "uk"
+      }
+    }
+
+    action {
+      sequence        = 4
+      description     = "Click Login"
+      always_required = true
+      type            = "CLICK"
+
+      click {
+        search_text  = "Login"
+        element_type = "button"
+      }
+    }
+  }
+
+  check_host_id  = endpointmonitor_check_host.test.id
+  check_group_id = endpointmonitor_check_group.test.id
+}
+`, revision, startURL)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.Checks),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, "https://www.mycompany.com/login"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_check.test", "start_url", "https://www.mycompany.com/login"),
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_check.test", "step.#", "2"),
+					resource.TestCheckResourceAttrPair("endpointmonitor_web_journey_check.test", "step.0.common_step_id", "endpointmonitor_web_journey_common_step.test", "id"),
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_check.test", "step.1.page_check.1.check_element_on_page.element_id", "login_form"),
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_check.test", "step.1.action.#", "4"),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_web_journey_check.test", map[string]any{
+						"checkType": "WEB_JOURNEY",
+						"startUrl":  "https://www.mycompany.com/login",
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_web_journey_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, "https://www.mycompany.com/signin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_check.test", "description", "Test web journey check, revision 2."),
+					testAccCheckStored(server, fakeepm.Checks, "endpointmonitor_web_journey_check.test", map[string]any{
+						"startUrl": "https://www.mycompany.com/signin",
+					}),
+				),
+			},
+		},
+	})
+}
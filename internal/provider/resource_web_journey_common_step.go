@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -23,7 +25,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &WebJourneyCommonStepResource{}
+	_ resource.Resource                 = &WebJourneyCommonStepResource{}
+	_ resource.ResourceWithModifyPlan   = &WebJourneyCommonStepResource{}
+	_ resource.ResourceWithUpgradeState = &WebJourneyCommonStepResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -42,8 +46,9 @@ func (r *WebJourneyCommonStepResource) Metadata(_ context.Context, req resource.
 }
 
 // Schema defines the schema for the resource.
-func (r *WebJourneyCommonStepResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *WebJourneyCommonStepResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Create and manage web journey common steps which are used to provide common checks and actions to take for web journey checks.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -95,6 +100,7 @@ func (r *WebJourneyCommonStepResource) Schema(_ context.Context, _ resource.Sche
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 			"page_check": schema.ListNestedBlock{
 				Description: "The set of checks to run against the currently loaded content.",
 				NestedObject: schema.NestedBlockObject{
@@ -175,16 +181,16 @@ func (r *WebJourneyCommonStepResource) Schema(_ context.Context, _ resource.Sche
 										int64planmodifier.UseStateForUnknown(),
 									},
 								},
-								"elemenet_id": schema.StringAttribute{
+								"element_id": schema.StringAttribute{
 									Optional:    true,
-									Description: "The id of the element to check.",
+									Description: "The id of the element to check. Formerly elemenet_id, which is renamed in existing state.",
 									Validators: []validator.String{
 										stringvalidator.LengthAtLeast(1),
 									},
 								},
-								"elemenet_name": schema.StringAttribute{
+								"element_name": schema.StringAttribute{
 									Optional:    true,
-									Description: "The name of the element to check.",
+									Description: "The name of the element to check. Formerly elemenet_name, which is renamed in existing state.",
 									Validators: []validator.String{
 										stringvalidator.LengthAtLeast(1),
 									},
@@ -748,12 +754,18 @@ func (r *WebJourneyCommonStepResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	step, error := r.client.CreateWebJourneyCommonStep(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating check", "Could not create check", error)
 		return
 	}
 
@@ -770,6 +782,7 @@ func (r *WebJourneyCommonStepResource) Create(ctx context.Context, req resource.
 		}
 	}
 
+	step.Timeouts = plan.Timeouts
 	plan = *step
 
 	// Set state to fully populated data
@@ -790,8 +803,22 @@ func (r *WebJourneyCommonStepResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed check from EPM
-	step, err := r.client.GetCommonWebJourneyStep(state.Id.ValueInt64())
+	step, err := r.client.GetCommonWebJourneyStep(state.Id.ValueInt64(), ctx)
+	if errors.Is(err, ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Fetching Check",
@@ -800,11 +827,6 @@ func (r *WebJourneyCommonStepResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	if step == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Set state from returned data from EPM.
 	// Because the response we get from the EPM API doesn't contain the any password_input values,
 	// to be able to just copy its response to the state, we need to grab the passwords from the
@@ -831,6 +853,7 @@ func (r *WebJourneyCommonStepResource) Read(ctx context.Context, req resource.Re
 		}
 	}
 
+	step.Timeouts = state.Timeouts
 	state = *step
 
 	// Set refreshed state
@@ -850,12 +873,18 @@ func (r *WebJourneyCommonStepResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	step, error := r.client.UpdateWebJourneyCommonStep(plan, ctx)
 	if error != nil {
-		resp.Diagnostics.AddError(
-			"Error creating check",
-			"Could not create check, unexpected error: "+error.Error(),
-		)
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating check", "Could not update check", error)
 		return
 	}
 
@@ -872,6 +901,7 @@ func (r *WebJourneyCommonStepResource) Update(ctx context.Context, req resource.
 		}
 	}
 
+	step.Timeouts = plan.Timeouts
 	plan = *step
 
 	// Set state to fully populated data
@@ -885,10 +915,24 @@ func (r *WebJourneyCommonStepResource) Update(ctx context.Context, req resource.
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *WebJourneyCommonStepResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan WebJourneyCommonStepModel
-	req.State.Get(ctx, &plan)
-	err := r.client.DeleteWebCommonStep(plan.Id.ValueInt64())
+	diags := req.State.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteWebCommonStep(plan.Id.ValueInt64(), ctx)
+
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleteing check",
 			"Request to EPM to delete check returned an error: "+err.Error(),
@@ -897,6 +941,28 @@ func (r *WebJourneyCommonStepResource) Delete(ctx context.Context, req resource.
 	}
 }
 
+// ModifyPlan checks the planned changes to the resource.
+func (r *WebJourneyCommonStepResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	defer r.client.checkReadOnly(req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan WebJourneyCommonStepModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	uses := webJourneyStepFeatures(path.Empty(), plan.PageChecks, plan.Actions)
+
+	r.client.checkFeatures(ctx, uses, &resp.Diagnostics)
+}
+
 func (r *WebJourneyCommonStepResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
@@ -922,3 +988,11 @@ func (r *WebJourneyCommonStepResource) ImportState(ctx context.Context, req reso
 	id, _ := strconv.Atoi(req.ID)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// UpgradeState renames the misspelt attributes of check_element_on_page in states from
+// before version 1 of the schema.
+func (r *WebJourneyCommonStepResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: renameStateAttributes(pageCheckElementRenames),
+	}
+}
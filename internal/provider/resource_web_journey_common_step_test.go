@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-endpointmonitor/internal/fakeepm"
+)
+
+func TestAccWebJourneyCommonStepResource(t *testing.T) {
+	server := testAccFake(t)
+
+	config := func(revision int, waitTime int) string {
+		return testAccConfig(server, `
+resource "endpointmonitor_web_journey_common_step" "test" {
+  name                   = "Test Web Journey Common Step"
+  description            = "Test web journey common step, revision %d."
+  wait_time              = %d
+  page_load_time_warning = 2500
+  page_load_time_alert   = 5000
+
+  page_check {
+    description = "Check the cookie banner is shown."
+    type        = "CHECK_FOR_ELEMENT"
+
+    check_element_on_page {
+      element_id = "cookie_banner"
+      state      = "PRESENT"
+    }
+  }
+
+  action {
+    sequence        = 1
+    description     = "Accept cookies"
+    always_required = false
+    type            = "CLICK"
+
+    click {
+      search_text  = "Accept"
+      element_type = "button"
+    }
+  }
+
+  console_message_suppression {
+    description = "Suppress missing image"
+    log_level   = "ERROR"
+    message     = "Failed to load resource: net::ERR_NAME_NOT_RESOLVED"
+    comparison  = "CONTAINS"
+  }
+
+  network_suppression {
+    description   = "Suppress missing script"
+    url           = "nonexistent.js"
+    response_code = 404
+    comparison    = "ENDS_WITH"
+  }
+}
+`, revision, waitTime)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRemoved(server, fakeepm.WebJourneyCommonSteps),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1, 5000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_common_step.test", "wait_time", "5000"),
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_common_step.test", "page_check.0.check_element_on_page.element_id", "cookie_banner"),
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_common_step.test", "action.0.click.search_text", "Accept"),
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_common_step.test", "network_suppression.0.response_code", "404"),
+					testAccCheckStored(server, fakeepm.WebJourneyCommonSteps, "endpointmonitor_web_journey_common_step.test", map[string]any{
+						"name":     "Test Web Journey Common Step",
+						"waitTime": 5000,
+					}),
+				),
+			},
+			{
+				ResourceName:      "endpointmonitor_web_journey_common_step.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(2, 8000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("endpointmonitor_web_journey_common_step.test", "description", "Test web journey common step, revision 2."),
+					testAccCheckStored(server, fakeepm.WebJourneyCommonSteps, "endpointmonitor_web_journey_common_step.test", map[string]any{
+						"waitTime": 8000,
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const retryBaseWait = 1 * time.Second
+
+// errAttemptTimeout is returned for an attempt at a request that took longer than its
+// own deadline, which is retried like a dropped connection.
+var errAttemptTimeout = errors.New("request attempt timed out")
+
+// isIdempotent reports whether a request can be safely sent again. The EPM API
+// uses PUT for adding new items, so repeating one could create duplicates, whereas
+// updates (POST), reads and removals all target a fixed id.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetry reports whether the outcome of a request attempt looks like a
+// transient failure of the EPM controller or the connection to it.
+func shouldRetry(res *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, errAttemptTimeout) {
+			return true
+		}
+
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+
+		return errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, io.EOF) ||
+			errors.Is(err, io.ErrUnexpectedEOF)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// notProcessed reports whether a failed attempt can't have been acted on by the EPM
+// API, so even a request that adds an item can be sent again without creating a
+// duplicate. The connection being refused means the request never arrived, and a 429
+// or 503 with a Retry-After header is the server saying it turned the request away.
+func notProcessed(res *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNREFUSED)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return res.Header.Get("Retry-After") != ""
+	default:
+		return false
+	}
+}
+
+// retryWait works out how long to wait before the next attempt. A Retry-After
+// header from the server is honoured, otherwise an exponential backoff with
+// jitter is used. Either way the wait is capped at RetryMaxWait.
+func (c *EndPointMonitorClient) retryWait(attempt int, res *http.Response) time.Duration {
+	maxWait := c.RetryMaxWait
+	if maxWait <= 0 {
+		maxWait = defaultRetryMaxWait
+	}
+
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, maxWait)
+		}
+	}
+
+	wait := retryBaseWait << attempt
+	if wait <= 0 || wait > maxWait {
+		wait = maxWait
+	}
+
+	// Spread retries from parallel resource operations so they don't all land on
+	// the controller at the same moment.
+	jitter := time.Duration(rand.Int63n(int64(wait)/2 + 1))
+
+	return min(wait/2+jitter, maxWait)
+}
+
+// parseRetryAfter parses a Retry-After header given either as a number of seconds
+// or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// rewindBody resets the body of a request so it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+
+	req.Body = body
+
+	return nil
+}
+
+func sleepWithContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func describeRetryCause(res *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+
+	return fmt.Sprintf("status: %d", res.StatusCode)
+}
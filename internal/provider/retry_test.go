@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		wantMin time.Duration
+		wantMax time.Duration
+		wantOk  bool
+	}{
+		"empty":       {value: ""},
+		"seconds":     {value: "5", wantMin: 5 * time.Second, wantMax: 5 * time.Second, wantOk: true},
+		"zero":        {value: "0", wantOk: true},
+		"negative":    {value: "-1"},
+		"invalid":     {value: "soon"},
+		"future date": {value: time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), wantMin: 8 * time.Second, wantMax: 10 * time.Second, wantOk: true},
+		"past date":   {value: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), wantOk: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := parseRetryAfter(test.value)
+
+			if ok != test.wantOk {
+				t.Fatalf("parseRetryAfter(%q) ok = %t, want %t", test.value, ok, test.wantOk)
+			}
+
+			if got < test.wantMin || got > test.wantMax {
+				t.Errorf("parseRetryAfter(%q) = %s, want between %s and %s", test.value, got, test.wantMin, test.wantMax)
+			}
+		})
+	}
+}
+
+// testResponse returns a response with the given status and Retry-After header, which
+// is left out when empty.
+func testResponse(status int, retryAfter string) *http.Response {
+	res := &http.Response{StatusCode: status, Header: http.Header{}}
+	if retryAfter != "" {
+		res.Header.Set("Retry-After", retryAfter)
+	}
+
+	return res
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := map[string]struct {
+		res  *http.Response
+		err  error
+		want bool
+	}{
+		"ok":                  {res: testResponse(http.StatusOK, "")},
+		"bad request":         {res: testResponse(http.StatusBadRequest, "")},
+		"not found":           {res: testResponse(http.StatusNotFound, "")},
+		"internal error":      {res: testResponse(http.StatusInternalServerError, "")},
+		"too many requests":   {res: testResponse(http.StatusTooManyRequests, ""), want: true},
+		"bad gateway":         {res: testResponse(http.StatusBadGateway, ""), want: true},
+		"unavailable":         {res: testResponse(http.StatusServiceUnavailable, ""), want: true},
+		"gateway timeout":     {res: testResponse(http.StatusGatewayTimeout, ""), want: true},
+		"connection reset":    {err: fmt.Errorf("read: %w", syscall.ECONNRESET), want: true},
+		"connection refused":  {err: fmt.Errorf("dial: %w", syscall.ECONNREFUSED), want: true},
+		"eof":                 {err: io.EOF, want: true},
+		"unexpected eof":      {err: io.ErrUnexpectedEOF, want: true},
+		"attempt timeout":     {err: fmt.Errorf("%w: no response within 30s", errAttemptTimeout), want: true},
+		"cancelled":           {err: fmt.Errorf("Get: %w", context.Canceled)},
+		"operation timed out": {err: fmt.Errorf("Get: %w", context.DeadlineExceeded)},
+		"other error":         {err: errors.New("certificate signed by unknown authority")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := shouldRetry(test.res, test.err); got != test.want {
+				t.Errorf("shouldRetry() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNotProcessed(t *testing.T) {
+	tests := map[string]struct {
+		res  *http.Response
+		err  error
+		want bool
+	}{
+		"connection refused":               {err: fmt.Errorf("dial: %w", syscall.ECONNREFUSED), want: true},
+		"connection reset":                 {err: fmt.Errorf("read: %w", syscall.ECONNRESET)},
+		"attempt timeout":                  {err: errAttemptTimeout},
+		"too many requests with header":    {res: testResponse(http.StatusTooManyRequests, "1"), want: true},
+		"unavailable with header":          {res: testResponse(http.StatusServiceUnavailable, "1"), want: true},
+		"too many requests without header": {res: testResponse(http.StatusTooManyRequests, "")},
+		"unavailable without header":       {res: testResponse(http.StatusServiceUnavailable, "")},
+		"bad gateway with header":          {res: testResponse(http.StatusBadGateway, "1")},
+		"gateway timeout":                  {res: testResponse(http.StatusGatewayTimeout, "")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := notProcessed(test.res, test.err); got != test.want {
+				t.Errorf("notProcessed() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	for method, want := range map[string]bool{
+		http.MethodGet:    true,
+		http.MethodPost:   true,
+		http.MethodDelete: true,
+		http.MethodPut:    false,
+	} {
+		req, _ := http.NewRequest(method, "https://epm.invalid/api/checks", nil)
+
+		if got := isIdempotent(req); got != want {
+			t.Errorf("isIdempotent(%s) = %t, want %t", method, got, want)
+		}
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c := &EndPointMonitorClient{RetryMaxWait: 10 * time.Second}
+
+	// Each backoff is jittered between half and all of the doubling wait, capped at
+	// RetryMaxWait.
+	for attempt := 0; attempt < 8; attempt++ {
+		full := min(retryBaseWait<<attempt, c.RetryMaxWait)
+
+		for i := 0; i < 100; i++ {
+			if wait := c.retryWait(attempt, nil); wait < full/2 || wait > full {
+				t.Fatalf("retryWait(%d) = %s, want between %s and %s", attempt, wait, full/2, full)
+			}
+		}
+	}
+
+	if wait := c.retryWait(0, testResponse(http.StatusServiceUnavailable, "3")); wait != 3*time.Second {
+		t.Errorf("retryWait with Retry-After 3 = %s, want 3s", wait)
+	}
+
+	if wait := c.retryWait(0, testResponse(http.StatusServiceUnavailable, "3600")); wait != c.RetryMaxWait {
+		t.Errorf("retryWait with Retry-After 3600 = %s, want %s", wait, c.RetryMaxWait)
+	}
+
+	if wait := (&EndPointMonitorClient{}).retryWait(100, nil); wait > defaultRetryMaxWait {
+		t.Errorf("retryWait without RetryMaxWait = %s, want at most %s", wait, defaultRetryMaxWait)
+	}
+}
+
+// testRetryClient returns a client of the fake that retries quickly.
+func testRetryClient(t *testing.T, url string) *EndPointMonitorClient {
+	t.Helper()
+
+	client, err := NewEPMClient(EndPointMonitorClientConfig{
+		HostURL:      url,
+		ApiKey:       testAccKey,
+		MaxRetries:   3,
+		RetryMaxWait: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestDoRequestRetries(t *testing.T) {
+	server := testAccFake(t)
+	client := testRetryClient(t, server.APIURL())
+	ctx := context.Background()
+
+	created, err := client.CreateDashboardGroup(DashboardGroupModel{Name: types.StringValue("Operations"), Description: types.StringValue("")}, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A GET is retried after the server is unavailable.
+	server.Fail(http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+
+	if _, err := client.GetDashboardGroup(created.Id.ValueInt32(), ctx); err != nil {
+		t.Fatalf("GET wasn't retried: %v", err)
+	}
+
+	path := fmt.Sprintf("GET /api/dashboardGroups/%d", created.Id.ValueInt32())
+	if got := countRequests(server.Requests(), path); got != 3 {
+		t.Errorf("got %d attempts at %s, want 3", got, path)
+	}
+
+	// A PUT, adding an item, isn't retried without a Retry-After header, as the server
+	// may have added it.
+	server.Fail(http.StatusServiceUnavailable)
+
+	if _, err := client.CreateDashboardGroup(DashboardGroupModel{Name: types.StringValue("Development"), Description: types.StringValue("")}, ctx); err == nil {
+		t.Fatal("PUT succeeded, want the 503")
+	}
+
+	if got := countRequests(server.Requests(), "PUT /api/dashboardGroups/add"); got != 2 {
+		t.Errorf("got %d PUTs, want 2, one for each group", got)
+	}
+
+	// Retries are given up on once the operation is cancelled.
+	server.Fail(http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+
+	slow := testRetryClient(t, server.APIURL())
+	slow.RetryMaxWait = time.Minute
+
+	cancelled, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	if _, err := slow.GetDashboardGroup(created.Id.ValueInt32(), cancelled); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want the operation's deadline", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("gave up after %s, want as soon as the operation was cancelled", elapsed)
+	}
+}
+
+func countRequests(requests []string, request string) int {
+	count := 0
+
+	for _, r := range requests {
+		if r == request {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// buildTLSConfig creates the TLS configuration used to talk to the EPM API from the
+// CA bundle, client certificate and verification settings in the client config.
+// A nil config is returned when nothing needs to differ from Go's defaults.
+func buildTLSConfig(config EndPointMonitorClientConfig) (*tls.Config, error) {
+	if config.CACertFile == "" && config.CACertPEM == "" && config.ClientCert == "" && config.ClientKey == "" && !config.InsecureSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertFile != "" || config.CACertPEM != "" {
+		// Start from the system pool so public certificates, such as those presented
+		// by an outbound proxy, are still trusted alongside the internal CA.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if config.CACertFile != "" {
+			pem, err := os.ReadFile(config.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read CA certificate file: %w", err)
+			}
+
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no PEM encoded certificates found in CA certificate file %s", config.CACertFile)
+			}
+		}
+
+		if config.CACertPEM != "" {
+			if !pool.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+				return nil, errors.New("no PEM encoded certificates found in the given CA certificate PEM")
+			}
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, errors.New("both a client certificate and client key must be given to use mutual TLS")
+		}
+
+		certPEM, err := readPEMOrFile(config.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+
+		keyPEM, err := readPEMOrFile(config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key: %w", err)
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate and key: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEMOrFile returns the value as-is if it is PEM encoded content, otherwise
+// treats it as a path and returns the contents of that file.
+func readPEMOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
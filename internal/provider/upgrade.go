@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// pageCheckElementRenames are the attributes of check_element_on_page that were
+// misspelt before version 1 of the web journey check and common step schemas.
+var pageCheckElementRenames = map[string]string{
+	"elemenet_id":   "element_id",
+	"elemenet_name": "element_name",
+}
+
+// renameStateAttributes returns a state upgrader for a schema that only differs from
+// the prior version by the names of some attributes. They are renamed wherever they
+// appear in the raw state.
+func renameStateAttributes(renames map[string]string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade State",
+					"The prior state isn't JSON, so its attributes can't be renamed.",
+				)
+				return
+			}
+
+			var state any
+			if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade State",
+					"Unable to decode the prior state: "+err.Error(),
+				)
+				return
+			}
+
+			upgraded, err := json.Marshal(renameAttributes(state, renames))
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade State",
+					"Unable to encode the upgraded state: "+err.Error(),
+				)
+				return
+			}
+
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
+
+// renameAttributes renames the keys of every object within a decoded JSON value. An
+// old key is dropped rather than renamed if its new name is already present.
+func renameAttributes(value any, renames map[string]string) any {
+	switch value := value.(type) {
+	case map[string]any:
+		renamed := make(map[string]any, len(value))
+
+		for key, item := range value {
+			renamed[key] = renameAttributes(item, renames)
+		}
+
+		for from, to := range renames {
+			item, found := renamed[from]
+			if !found {
+				continue
+			}
+
+			if _, taken := renamed[to]; !taken {
+				renamed[to] = item
+			}
+
+			delete(renamed, from)
+		}
+
+		return renamed
+
+	case []any:
+		renamed := make([]any, len(value))
+
+		for i, item := range value {
+			renamed[i] = renameAttributes(item, renames)
+		}
+
+		return renamed
+
+	default:
+		return value
+	}
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testPageCheckElementState is the state of a check_element_on_page page check at
+// version 0, with its misspelt attributes.
+const testPageCheckElementState = `{
+  "id": 1,
+  "description": "Log in button shown",
+  "warning_only": false,
+  "type": "CHECK_ELEMENT_ON_PAGE",
+  "check_element_on_page": {
+    "id": 2,
+    "elemenet_id": "login",
+    "elemenet_name": null,
+    "state": "PRESENT"
+  }
+}`
+
+func TestUpgradeStateRenamesPageCheckElementAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource resource.ResourceWithUpgradeState
+		state    string
+	}{
+		{
+			name:     "web_journey_check",
+			resource: &WebJourneyCheckResource{},
+			state:    `{"id": 3, "name": "Log In Journey", "step": [{"id": 4, "page_check": [` + testPageCheckElementState + `]}]}`,
+		},
+		{
+			name:     "web_journey_common_step",
+			resource: &WebJourneyCommonStepResource{},
+			state:    `{"id": 3, "name": "Log In", "page_check": [` + testPageCheckElementState + `]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			schemaResp := &resource.SchemaResponse{}
+			test.resource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			if schemaResp.Schema.Version != 1 {
+				t.Fatalf("got schema version %d, want 1", schemaResp.Schema.Version)
+			}
+
+			upgrader, found := test.resource.UpgradeState(ctx)[0]
+			if !found {
+				t.Fatal("no upgrader from version 0")
+			}
+
+			resp := &resource.UpgradeStateResponse{}
+			upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(test.state)}}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("upgrade failed: %v", resp.Diagnostics)
+			}
+
+			var upgraded any
+			if err := json.Unmarshal(resp.DynamicValue.JSON, &upgraded); err != nil {
+				t.Fatal(err)
+			}
+
+			var want any
+			if err := json.Unmarshal([]byte(test.state), &want); err != nil {
+				t.Fatal(err)
+			}
+
+			pageChecks := want.(map[string]any)
+			if steps, found := pageChecks["step"]; found {
+				pageChecks = steps.([]any)[0].(map[string]any)
+			}
+
+			element := pageChecks["page_check"].([]any)[0].(map[string]any)["check_element_on_page"].(map[string]any)
+			element["element_id"], element["element_name"] = element["elemenet_id"], element["elemenet_name"]
+			delete(element, "elemenet_id")
+			delete(element, "elemenet_name")
+
+			if diff := cmp.Diff(want, upgraded); diff != "" {
+				t.Errorf("upgraded state differs (-want +got):\n%s", diff)
+			}
+
+			_, err := tftypes.ValueFromJSONWithOpts(resp.DynamicValue.JSON, schemaResp.Schema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{})
+			if err != nil {
+				t.Errorf("upgraded state doesn't match the schema: %s", err)
+			}
+		})
+	}
+}
+
+func TestRenameAttributesKeepsNewName(t *testing.T) {
+	got := renameAttributes(map[string]any{"elemenet_id": "old", "element_id": "new"}, pageCheckElementRenames)
+
+	if diff := cmp.Diff(map[string]any{"element_id": "new"}, got); diff != "" {
+		t.Errorf("renamed attributes differ (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,103 @@
+// Package redact decides which values in the provider's traffic with the EndPoint
+// Monitor API are secret, so the provider's logs and recorded cassettes hide the same
+// ones.
+package redact
+
+import (
+	"net/http"
+	"strings"
+)
+
+// secretFields are looked for in JSON field names, ignoring case, to find values to
+// redact. secretNames must match a field name exactly.
+var (
+	secretFields = []string{"password", "secret", "token", "apikey"}
+	secretNames  = []string{"key"}
+)
+
+// headerListFields hold the request headers a URL check sends, whose values may be
+// credentials for the site being checked, such as an Authorization header.
+var headerListFields = []string{"requestHeaders"}
+
+// secretHeaders are the HTTP headers that carry credentials.
+var secretHeaders = []string{"X-Epm-Auth", "Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// Field reports whether the value of a JSON field is secret. parent is the name of the
+// field holding the field's object, or the list of objects it is in.
+func Field(parent string, name string) bool {
+	for _, field := range headerListFields {
+		if parent == field && name == "value" {
+			return true
+		}
+	}
+
+	name = strings.ToLower(name)
+
+	for _, secret := range secretNames {
+		if name == secret {
+			return true
+		}
+	}
+
+	for _, secret := range secretFields {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Header reports whether the value of an HTTP header is secret.
+func Header(name string) bool {
+	name = http.CanonicalHeaderKey(name)
+
+	for _, secret := range secretHeaders {
+		if name == secret {
+			return true
+		}
+	}
+
+	return false
+}
+
+// JSON replaces the values of secret fields within a decoded JSON value with
+// replacement, along with those of any fields named in also, and returns it. The value
+// is changed in place. Only strings, numbers and booleans are replaced. Objects and
+// lists are searched instead, so an object such as webJourneyPasswordInputAction keeps
+// its shape, and null values are left alone, as they give nothing away.
+func JSON(value any, replacement string, also ...string) any {
+	return redactJSON(value, "", "", replacement, also)
+}
+
+// redactJSON redacts a value held by the field name, of an object held by parent.
+// Items of a list are redacted as if they were held by the field holding the list.
+func redactJSON(value any, parent string, name string, replacement string, also []string) any {
+	switch v := value.(type) {
+	case nil:
+	case map[string]any:
+		for field, item := range v {
+			v[field] = redactJSON(item, name, field, replacement, also)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactJSON(item, parent, name, replacement, also)
+		}
+	default:
+		if name != "" && (Field(parent, name) || contains(also, name)) {
+			return replacement
+		}
+	}
+
+	return value
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,89 @@
+package redact_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"terraform-provider-endpointmonitor/internal/redact"
+)
+
+func TestField(t *testing.T) {
+	tests := []struct {
+		parent string
+		name   string
+		want   bool
+	}{
+		{name: "password", want: true},
+		{name: "newInputPassword", want: true},
+		{name: "clientSecret", want: true},
+		{name: "access_token", want: true},
+		{name: "apiKey", want: true},
+		{name: "key", want: true},
+		{name: "name", want: false},
+		{name: "keyword", want: false},
+		{name: "value", want: false},
+		{parent: "requestHeaders", name: "value", want: true},
+		{parent: "requestHeaders", name: "name", want: false},
+		{parent: "steps", name: "value", want: false},
+	}
+
+	for _, test := range tests {
+		if got := redact.Field(test.parent, test.name); got != test.want {
+			t.Errorf("Field(%q, %q) = %t, want %t", test.parent, test.name, got, test.want)
+		}
+	}
+}
+
+func TestHeader(t *testing.T) {
+	tests := map[string]bool{
+		"x-epm-auth":          true,
+		"Authorization":       true,
+		"proxy-authorization": true,
+		"Cookie":              true,
+		"Content-Type":        false,
+		"Accept":              false,
+	}
+
+	for name, want := range tests {
+		if got := redact.Header(name); got != want {
+			t.Errorf("Header(%q) = %t, want %t", name, got, want)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	body := `{
+		"name": "Home Page",
+		"requestHeaders": [{"name": "Authorization", "value": "Bearer abc"}],
+		"steps": [{"actions": [{"webJourneyPasswordInputAction": {"newPassword": "hunter2", "xpath": null}}]}],
+		"tokens": ["abc", "def"],
+		"description": null,
+		"password": null,
+		"apk": "UEsDBBQ="
+	}`
+
+	want := `{
+		"name": "Home Page",
+		"requestHeaders": [{"name": "Authorization", "value": "***"}],
+		"steps": [{"actions": [{"webJourneyPasswordInputAction": {"newPassword": "***", "xpath": null}}]}],
+		"tokens": ["***", "***"],
+		"description": null,
+		"password": null,
+		"apk": "***"
+	}`
+
+	var value, wantValue any
+	if err := json.Unmarshal([]byte(body), &value); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := json.Marshal(redact.JSON(value, "***", "apk"))
+	wanted, _ := json.Marshal(wantValue)
+
+	if string(got) != string(wanted) {
+		t.Errorf("got %s, want %s", got, wanted)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"flag"
 	"log"
 
+	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
 	"terraform-provider-endpointmonitor/internal/provider"
@@ -35,7 +36,14 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	p := provider.New(version)()
+
+	err := providerserver.Serve(context.Background(), func() tfprovider.Provider { return p }, opts)
+
+	// Cassettes are only written once the provider has stopped serving Terraform.
+	if cassetteErr := provider.CloseCassettes(p); cassetteErr != nil {
+		log.Print(cassetteErr.Error())
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())